@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.uber.org/zap"
+)
+
+const accountAttribute = "upcloud.account"
+
+// accountScraper pairs the effective configuration of one account with the
+// client that authenticates as that account. The implicit account used when
+// no accounts are configured has an empty name.
+type accountScraper struct {
+	name   string
+	cfg    *Config
+	client Client
+	state  *scrapeState
+}
+
+func newAccountScraper(name string, cfg *Config, client Client, settings receiver.Settings) accountScraper {
+	return accountScraper{name: name, cfg: cfg, client: client, state: newScrapeState(cfg, settings)}
+}
+
+// checkpoints returns the backfill checkpoints of the account, or nil when
+// backfill is disabled.
+func (a accountScraper) checkpoints() *checkpoints {
+	if a.state == nil {
+		return nil
+	}
+	return a.state.checkpoints
+}
+
+// forAccount returns the configuration used to scrape account: the top-level
+// api and resource blocks with the account credentials and overrides applied.
+// Account credentials, inline or an authenticator, replace all top-level
+// credentials; setting both on one account fails validation.
+func (cfg *Config) forAccount(account AccountConfig) *Config {
+	effective := *cfg
+	effective.Accounts = nil
+
+	if account.hasCredentials() || account.Auth.HasValue() {
+		effective.API.Token = account.Token
+		effective.API.TokenFile = account.TokenFile
+		effective.API.Username = account.Username
+		effective.API.Password = account.Password
+		effective.API.PasswordFile = account.PasswordFile
+		effective.API.Auth = account.Auth
+	}
+
+	db := account.ManagedDatabases
+	if db.Enabled != nil {
+		effective.ManagedDatabases.Enabled = *db.Enabled
+	}
+	if db.AutoDiscover != nil {
+		effective.ManagedDatabases.AutoDiscover = *db.AutoDiscover
+	}
+	if db.UUIDs != nil {
+		effective.ManagedDatabases.UUIDs = db.UUIDs
+	}
+	if db.ExcludeUUIDs != nil {
+		effective.ManagedDatabases.ExcludeUUIDs = db.ExcludeUUIDs
+	}
+	if db.Selector != nil {
+		effective.ManagedDatabases.Selector = *db.Selector
+	}
+	if db.Period != "" {
+		effective.ManagedDatabases.Period = db.Period
+	}
+	if db.Metrics != nil {
+		effective.ManagedDatabases.Metrics = db.Metrics
+	}
+
+	lb := account.ManagedLoadBalancers
+	if lb.Enabled != nil {
+		effective.ManagedLoadBalancers.Enabled = *lb.Enabled
+	}
+	if lb.AutoDiscover != nil {
+		effective.ManagedLoadBalancers.AutoDiscover = *lb.AutoDiscover
+	}
+	if lb.UUIDs != nil {
+		effective.ManagedLoadBalancers.UUIDs = lb.UUIDs
+	}
+	if lb.ExcludeUUIDs != nil {
+		effective.ManagedLoadBalancers.ExcludeUUIDs = lb.ExcludeUUIDs
+	}
+	if lb.Selector != nil {
+		effective.ManagedLoadBalancers.Selector = *lb.Selector
+	}
+	if lb.Period != "" {
+		effective.ManagedLoadBalancers.Period = lb.Period
+	}
+	if lb.Metrics != nil {
+		effective.ManagedLoadBalancers.Metrics = lb.Metrics
+	}
+	return &effective
+}
+
+func (account AccountConfig) hasCredentials() bool {
+	return strings.TrimSpace(string(account.Token)) != "" ||
+		strings.TrimSpace(account.TokenFile) != "" ||
+		strings.TrimSpace(account.Username) != "" ||
+		strings.TrimSpace(string(account.Password)) != "" ||
+		strings.TrimSpace(account.PasswordFile) != ""
+}
+
+// scrapeAccounts scrapes every account and merges the results. A failing
+// account never prevents the other accounts from being scraped or emitted;
+// its failed resources are added to the combined partial scrape error.
+// Accounts with a spread schedule are scraped concurrently, since each of
+// them takes most of the collection interval; the others one after another.
+func scrapeAccounts(ctx context.Context, accounts []accountScraper, logger *zap.Logger) (pmetric.Metrics, error) {
+	type accountResult struct {
+		metrics pmetric.Metrics
+		err     error
+	}
+	results := make([]accountResult, len(accounts))
+	var wg sync.WaitGroup
+	for idx, account := range accounts {
+		accountLogger := logger
+		if account.name != "" {
+			accountLogger = logger.With(zap.String("account", account.name))
+		}
+		scrape := func() {
+			results[idx].metrics, results[idx].err = scrapeMetrics(ctx, account.client, account.cfg, account.state, accountLogger)
+		}
+		if account.state.schedule == nil {
+			scrape()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scrape()
+		}()
+	}
+	wg.Wait()
+
+	out := pmetric.NewMetrics()
+	var errs scrapererror.ScrapeErrors
+	for idx, account := range accounts {
+		metrics, err := results[idx].metrics, results[idx].err
+		if err != nil {
+			failed := 0
+			var partialErr scrapererror.PartialScrapeError
+			if errors.As(err, &partialErr) {
+				failed = partialErr.Failed
+			}
+			if account.name != "" {
+				err = fmt.Errorf("account %s: %w", account.name, err)
+			}
+			errs.AddPartial(failed, err)
+		}
+
+		rms := metrics.ResourceMetrics()
+		if account.name != "" {
+			for i := 0; i < rms.Len(); i++ {
+				rms.At(i).Resource().Attributes().PutStr(accountAttribute, account.name)
+			}
+		}
+		rms.MoveAndAppendTo(out.ResourceMetrics())
+	}
+	return out, errs.Combine()
+}
@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+type failingClient struct {
+	fakeClient
+}
+
+func (failingClient) GetManagedDatabaseMetrics(context.Context, string, string) (MetricsResponse, error) {
+	return nil, errors.New("boom")
+}
+
+func TestConfigForAccount(t *testing.T) {
+	disabled := false
+	cfg := &Config{
+		API: APIConfig{
+			ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10},
+			Token:        "shared-token",
+		},
+		ManagedDatabases: ManagedDatabaseConfig{
+			Enabled:      true,
+			AutoDiscover: true,
+			Period:       "hour",
+		},
+		ManagedLoadBalancers: ManagedLoadBalancerConfig{Enabled: true, AutoDiscover: true},
+	}
+
+	effective := cfg.forAccount(AccountConfig{
+		Name:      "staging",
+		TokenFile: "/var/run/secrets/staging-token",
+		ManagedDatabases: AccountResourceConfig{
+			UUIDs:    []string{"db-1"},
+			Period:   "day",
+			Selector: &SelectorConfig{Labels: map[string]string{"env": "staging"}},
+		},
+		ManagedLoadBalancers: AccountResourceConfig{Enabled: &disabled},
+	})
+
+	if effective.API.Token != "" || effective.API.TokenFile != "/var/run/secrets/staging-token" {
+		t.Fatalf("expected account credentials to replace shared credentials, got token=%q token_file=%q", effective.API.Token, effective.API.TokenFile)
+	}
+	if effective.API.Endpoint != cfg.API.Endpoint {
+		t.Fatalf("expected endpoint to be inherited, got %q", effective.API.Endpoint)
+	}
+	if !effective.ManagedDatabases.AutoDiscover || effective.ManagedDatabases.Period != "day" || len(effective.ManagedDatabases.UUIDs) != 1 {
+		t.Fatalf("unexpected managed database overrides: %+v", effective.ManagedDatabases)
+	}
+	if effective.ManagedDatabases.Selector.Labels["env"] != "staging" {
+		t.Fatalf("expected the account selector, got %+v", effective.ManagedDatabases.Selector)
+	}
+	if effective.ManagedLoadBalancers.Enabled {
+		t.Fatalf("expected load balancers to be disabled for the account")
+	}
+	if cfg.ManagedDatabases.Period != "hour" || !cfg.ManagedLoadBalancers.Enabled {
+		t.Fatalf("forAccount must not modify the top-level config")
+	}
+
+	inherited := cfg.forAccount(AccountConfig{Name: "prod"})
+	if inherited.API.Token != "shared-token" {
+		t.Fatalf("expected account without credentials to inherit shared token")
+	}
+}
+
+func TestConfigValidate_Accounts(t *testing.T) {
+	base := func() Config {
+		return Config{
+			ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+			API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}},
+			ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+		}
+	}
+
+	valid := base()
+	valid.Accounts = []AccountConfig{{Name: "prod", Token: "prod-token"}, {Name: "staging", Token: "staging-token"}}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("expected valid accounts config, got %v", err)
+	}
+
+	duplicate := base()
+	duplicate.Accounts = []AccountConfig{{Name: "prod", Token: "a"}, {Name: "prod", Token: "b"}}
+	if err := duplicate.Validate(); err == nil {
+		t.Fatalf("expected duplicate account names to be rejected")
+	}
+
+	missingCredentials := base()
+	missingCredentials.Accounts = []AccountConfig{{Name: "prod"}}
+	if err := missingCredentials.Validate(); err == nil {
+		t.Fatalf("expected account without credentials and no shared credentials to be rejected")
+	}
+
+	authenticator := base()
+	authenticator.Accounts = []AccountConfig{{
+		Name: "prod",
+		Auth: configoptional.Some(configauth.Config{AuthenticatorID: component.MustNewID("oauth2client")}),
+	}}
+	if err := authenticator.Validate(); err != nil {
+		t.Fatalf("expected account with an authenticator to be valid, got %v", err)
+	}
+
+	mixed := base()
+	mixed.Accounts = []AccountConfig{{
+		Name:  "prod",
+		Token: "prod-token",
+		Auth:  configoptional.Some(configauth.Config{AuthenticatorID: component.MustNewID("oauth2client")}),
+	}}
+	if err := mixed.Validate(); err == nil {
+		t.Fatalf("expected account with both a token and an authenticator to be rejected")
+	}
+}
+
+func TestConfigForAccount_Authenticator(t *testing.T) {
+	sharedAuth := configoptional.Some(configauth.Config{AuthenticatorID: component.MustNewID("bearertokenauth", "shared")})
+	cfg := &Config{
+		API: APIConfig{
+			ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10, Auth: sharedAuth},
+		},
+	}
+
+	accountID := component.MustNewID("bearertokenauth", "staging")
+	effective := cfg.forAccount(AccountConfig{
+		Name: "staging",
+		Auth: configoptional.Some(configauth.Config{AuthenticatorID: accountID}),
+	})
+	if !effective.API.Auth.HasValue() || effective.API.Auth.Get().AuthenticatorID != accountID {
+		t.Fatalf("expected the account authenticator to be used, got %+v", effective.API.Auth)
+	}
+
+	effective = cfg.forAccount(AccountConfig{Name: "prod", Token: "prod-token"})
+	if effective.API.Auth.HasValue() {
+		t.Fatalf("expected inline account credentials to replace the shared authenticator")
+	}
+
+	effective = cfg.forAccount(AccountConfig{Name: "dev"})
+	if !effective.API.Auth.HasValue() || effective.API.Auth.Get().AuthenticatorID != sharedAuth.Get().AuthenticatorID {
+		t.Fatalf("expected account without credentials to inherit the shared authenticator")
+	}
+}
+
+func TestScrapeAccounts_FailingAccountDoesNotBlockOthers(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+	}
+	healthy := &fakeClient{
+		dbResp: MetricsResponse{
+			"cpu_usage": {
+				Data: MetricsData{
+					Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}},
+					Rows: [][]any{{"2026-02-21T08:00:00Z", 10.0}},
+				},
+			},
+		},
+	}
+
+	settings := receivertest.NewNopSettings(metadata.Type)
+	metrics, err := scrapeAccounts(context.Background(), []accountScraper{
+		newAccountScraper("broken", cfg, &failingClient{}, settings),
+		newAccountScraper("prod", cfg, healthy, settings),
+	}, zap.NewNop())
+	var partialErr scrapererror.PartialScrapeError
+	if !errors.As(err, &partialErr) {
+		t.Fatalf("expected partial scrape error, got %v", err)
+	}
+	if partialErr.Failed != 1 {
+		t.Fatalf("expected 1 failed resource, got %d", partialErr.Failed)
+	}
+	if metrics.ResourceMetrics().Len() != 1 {
+		t.Fatalf("expected 1 resource metrics from the healthy account, got %d", metrics.ResourceMetrics().Len())
+	}
+	attrs := metrics.ResourceMetrics().At(0).Resource().Attributes().AsRaw()
+	if attrs[accountAttribute] != "prod" {
+		t.Fatalf("expected upcloud.account=prod, got %v", attrs[accountAttribute])
+	}
+
+	if _, err := scrapeAccounts(context.Background(), []accountScraper{
+		newAccountScraper("broken", cfg, &failingClient{}, settings),
+	}, zap.NewNop()); err == nil {
+		t.Fatalf("expected error when every account fails")
+	}
+}
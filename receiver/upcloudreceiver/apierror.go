@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIErrorKind classifies an UpCloud API error.
+type APIErrorKind string
+
+const (
+	APIErrorKindUnauthorized APIErrorKind = "unauthorized"
+	APIErrorKindForbidden    APIErrorKind = "forbidden"
+	APIErrorKindNotFound     APIErrorKind = "not_found"
+	APIErrorKindThrottled    APIErrorKind = "throttled"
+	APIErrorKindServer       APIErrorKind = "server"
+	APIErrorKindClient       APIErrorKind = "client"
+)
+
+// maxErrorBodyBytes bounds how much of an error response body is read.
+const maxErrorBodyBytes = 64 << 10
+
+// APIError is returned for non-200 responses from the UpCloud API. It carries
+// the structured error document when the API provides one.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Endpoint   string
+
+	retryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "upcloud api returned status %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&b, " (%s)", e.Code)
+	}
+	fmt.Fprintf(&b, " for %s", e.Endpoint)
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	return b.String()
+}
+
+// Kind classifies the error by HTTP status, falling back to the UpCloud error
+// code for generic 4xx statuses.
+func (e *APIError) Kind() APIErrorKind {
+	switch {
+	case e.StatusCode == http.StatusUnauthorized:
+		return APIErrorKindUnauthorized
+	case e.StatusCode == http.StatusForbidden:
+		return APIErrorKindForbidden
+	case e.StatusCode == http.StatusNotFound:
+		return APIErrorKindNotFound
+	case e.StatusCode == http.StatusTooManyRequests:
+		return APIErrorKindThrottled
+	case e.StatusCode >= http.StatusInternalServerError:
+		return APIErrorKindServer
+	}
+
+	code := strings.ToUpper(e.Code)
+	switch {
+	case code == "AUTHENTICATION_FAILED":
+		return APIErrorKindUnauthorized
+	case strings.HasSuffix(code, "_FORBIDDEN") || code == "PERMISSION_DENIED":
+		return APIErrorKindForbidden
+	case strings.HasSuffix(code, "_NOT_FOUND"):
+		return APIErrorKindNotFound
+	case code == "RATE_LIMITED" || code == "TOO_MANY_REQUESTS":
+		return APIErrorKindThrottled
+	}
+	return APIErrorKindClient
+}
+
+// Retryable reports whether the request may succeed when repeated unchanged.
+func (e *APIError) Retryable() bool {
+	kind := e.Kind()
+	return kind == APIErrorKindThrottled || kind == APIErrorKindServer
+}
+
+// Permanent reports whether repeating the request is pointless until
+// configuration or the target resource changes.
+func (e *APIError) Permanent() bool {
+	return !e.Retryable()
+}
+
+// apiErrorDocument covers both the 1.3 error envelope
+// ({"error": {"error_code", "error_message"}}) and problem+json documents.
+type apiErrorDocument struct {
+	Error *struct {
+		ErrorCode    string `json:"error_code"`
+		ErrorMessage string `json:"error_message"`
+	} `json:"error"`
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+func newAPIError(resp *http.Response, endpointPath string) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Endpoint:   endpointPath,
+		retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	var doc apiErrorDocument
+	if err := json.Unmarshal(body, &doc); err == nil {
+		switch {
+		case doc.Error != nil:
+			apiErr.Code = strings.TrimSpace(doc.Error.ErrorCode)
+			apiErr.Message = strings.TrimSpace(doc.Error.ErrorMessage)
+		case doc.Type != "" || doc.Title != "":
+			apiErr.Code = problemTypeCode(doc.Type)
+			apiErr.Message = strings.TrimSpace(doc.Detail)
+			if apiErr.Message == "" {
+				apiErr.Message = strings.TrimSpace(doc.Title)
+			}
+		}
+		return apiErr
+	}
+
+	const maxMessageLen = 256
+	message := strings.TrimSpace(string(body))
+	if len(message) > maxMessageLen {
+		message = message[:maxMessageLen] + "..."
+	}
+	apiErr.Message = message
+	return apiErr
+}
+
+// problemTypeCode extracts the error code from a problem type URI such as
+// "https://developers.upcloud.com/1.3/errors#ERROR_NOT_FOUND".
+func problemTypeCode(problemType string) string {
+	problemType = strings.TrimSpace(problemType)
+	if idx := strings.LastIndexAny(problemType, "#/"); idx >= 0 {
+		return problemType[idx+1:]
+	}
+	return problemType
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+func TestHTTPClient_APIErrorFromErrorDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"error_code":"DATABASE_NOT_FOUND","error_message":"The database does not exist."}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+		},
+		Token: "fixture-token",
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		t.Fatalf("new http client: %v", err)
+	}
+
+	_, err = client.GetManagedDatabaseMetrics(context.Background(), "db-uuid", "hour")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound ||
+		apiErr.Code != "DATABASE_NOT_FOUND" ||
+		apiErr.Message != "The database does not exist." ||
+		apiErr.Endpoint != "/1.3/database/db-uuid/metrics" {
+		t.Fatalf("unexpected api error fields: %+v", apiErr)
+	}
+	if apiErr.Kind() != APIErrorKindNotFound || !apiErr.Permanent() {
+		t.Fatalf("expected permanent not_found error, got kind=%s", apiErr.Kind())
+	}
+}
+
+func TestHTTPClient_APIErrorFromProblemDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"type":"https://developers.upcloud.com/1.3/errors#ACTION_FORBIDDEN","title":"Forbidden","status":403}`))
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+		},
+		Token: "fixture-token",
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		t.Fatalf("new http client: %v", err)
+	}
+
+	_, err = client.ListManagedLoadBalancers(context.Background(), "/1.3/load-balancer")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Code != "ACTION_FORBIDDEN" || apiErr.Message != "Forbidden" {
+		t.Fatalf("unexpected api error fields: %+v", apiErr)
+	}
+	if apiErr.Kind() != APIErrorKindForbidden {
+		t.Fatalf("expected forbidden, got %s", apiErr.Kind())
+	}
+}
+
+func TestAPIErrorKind(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       APIError
+		want      APIErrorKind
+		retryable bool
+	}{
+		{name: "expired token", err: APIError{StatusCode: 401, Code: "AUTHENTICATION_FAILED"}, want: APIErrorKindUnauthorized},
+		{name: "missing permission", err: APIError{StatusCode: 403}, want: APIErrorKindForbidden},
+		{name: "deleted resource", err: APIError{StatusCode: 404}, want: APIErrorKindNotFound},
+		{name: "throttled", err: APIError{StatusCode: 429}, want: APIErrorKindThrottled, retryable: true},
+		{name: "server error", err: APIError{StatusCode: 503}, want: APIErrorKindServer, retryable: true},
+		{name: "code fallback", err: APIError{StatusCode: 400, Code: "SERVER_NOT_FOUND"}, want: APIErrorKindNotFound},
+		{name: "generic client error", err: APIError{StatusCode: 400, Code: "INVALID_PERIOD"}, want: APIErrorKindClient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Kind(); got != tt.want {
+				t.Fatalf("Kind() = %s, want %s", got, tt.want)
+			}
+			if got := tt.err.Retryable(); got != tt.retryable {
+				t.Fatalf("Retryable() = %v, want %v", got, tt.retryable)
+			}
+		})
+	}
+}
+
+type unauthorizedClient struct {
+	fakeClient
+	calls int
+}
+
+func (c *unauthorizedClient) GetManagedDatabaseMetrics(_ context.Context, uuid string, _ string) (MetricsResponse, error) {
+	c.calls++
+	return nil, &APIError{StatusCode: http.StatusUnauthorized, Code: "AUTHENTICATION_FAILED", Endpoint: "/1.3/database/" + uuid + "/metrics"}
+}
+
+func TestScrapeMetrics_StopsOnUnauthorized(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases: ManagedDatabaseConfig{
+			Enabled: true,
+			UUIDs:   []string{"db-1", "db-2", "db-3"},
+		},
+	}
+	client := &unauthorizedClient{}
+
+	_, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
+	if !scrapererror.IsPartialScrapeError(err) || !strings.Contains(err.Error(), "status 401") {
+		t.Fatalf("expected partial scrape error carrying the unauthorized response, got %v", err)
+	}
+	if client.calls != 1 {
+		t.Fatalf("expected scrape to stop after the first unauthorized response, got %d calls", client.calls)
+	}
+}
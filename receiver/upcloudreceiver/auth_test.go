@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/extension/extensionauth"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type fakeAuthExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	token string
+}
+
+var _ extensionauth.HTTPClient = (*fakeAuthExtension)(nil)
+
+func (e *fakeAuthExtension) RoundTripper(base http.RoundTripper) (http.RoundTripper, error) {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+e.token)
+		return base.RoundTrip(req)
+	}), nil
+}
+
+type extensionsHost map[component.ID]component.Component
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h
+}
+
+func TestHTTPClient_AuthenticatorExtension(t *testing.T) {
+	dbFixture := mustReadFixture(t, "testdata/integration/managed_database_metrics.json")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer rotated-token" {
+			t.Errorf("unexpected authorization header: %q", got)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(dbFixture)
+	}))
+	defer server.Close()
+
+	authID := component.MustNewID("fakeauth")
+	host := extensionsHost{authID: &fakeAuthExtension{token: "rotated-token"}}
+
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+			Auth:     configoptional.Some(configauth.Config{AuthenticatorID: authID}),
+		},
+	}, defaultLoadBalancerMetricsTemplate, host, componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		t.Fatalf("new http client: %v", err)
+	}
+
+	if _, err := client.GetManagedDatabaseMetrics(context.Background(), "db-uuid", "hour"); err != nil {
+		t.Fatalf("get managed database metrics: %v", err)
+	}
+}
+
+func TestHTTPClient_AuthenticatorExtensionMissing(t *testing.T) {
+	_, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: "https://api.upcloud.com",
+			Timeout:  2 * time.Second,
+			Auth:     configoptional.Some(configauth.Config{AuthenticatorID: component.MustNewID("missing")}),
+		},
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	if err == nil {
+		t.Fatalf("expected error for unknown authenticator extension")
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// checkpointStateVersion is bumped whenever the persisted layout changes;
+// state written with another version is discarded.
+const checkpointStateVersion = 1
+
+// seriesKey identifies one exported series of a resource metric.
+type seriesKey struct {
+	ResourceType string
+	ResourceUUID string
+	Metric       string
+	Series       string
+}
+
+// checkpoints remembers the newest exported timestamp of every series, so
+// backfill emits each row of the requested period exactly once. A scrape
+// records its progress as pending; the pending checkpoints are committed once
+// the batch was consumed, so rows of a rejected batch are emitted again.
+type checkpoints struct {
+	mu        sync.Mutex
+	committed map[seriesKey]time.Time
+	pending   map[seriesKey]time.Time
+	// forgotten holds the resources whose checkpoints are dropped on commit.
+	forgotten map[string]struct{}
+}
+
+func newCheckpoints() *checkpoints {
+	return &checkpoints{
+		committed: make(map[seriesKey]time.Time),
+		pending:   make(map[seriesKey]time.Time),
+		forgotten: make(map[string]struct{}),
+	}
+}
+
+// since returns the timestamp of the newest row already exported for key, or
+// the zero time when nothing was exported yet.
+func (c *checkpoints) since(key seriesKey) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.committed[key]
+}
+
+// resourceLatest returns the newest committed checkpoint of any series of a
+// resource, or the zero time when the resource has none. Series that stopped
+// being returned keep an old checkpoint, so the newest one is what tells when
+// the resource was last exported. It is nil-safe.
+func (c *checkpoints) resourceLatest(resourceType string, uuid string) time.Time {
+	if c == nil {
+		return time.Time{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var latest time.Time
+	for key, ts := range c.committed {
+		if key.ResourceType == resourceType && key.ResourceUUID == uuid && ts.After(latest) {
+			latest = ts
+		}
+	}
+	return latest
+}
+
+// advance records that rows of key up to ts were emitted; older timestamps are ignored.
+func (c *checkpoints) advance(key seriesKey, ts time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ts.After(c.committed[key]) && ts.After(c.pending[key]) {
+		c.pending[key] = ts
+	}
+}
+
+// commit makes the pending checkpoints permanent and reports whether anything changed.
+func (c *checkpoints) commit() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	changed := false
+	for key, ts := range c.pending {
+		if ts.After(c.committed[key]) {
+			c.committed[key] = ts
+			changed = true
+		}
+	}
+	if len(c.forgotten) > 0 {
+		for key := range c.committed {
+			if _, ok := c.forgotten[resourceKey(key.ResourceType, key.ResourceUUID)]; ok {
+				delete(c.committed, key)
+				changed = true
+			}
+		}
+	}
+	clear(c.pending)
+	clear(c.forgotten)
+	return changed
+}
+
+// discard drops the pending checkpoints of a batch that was not consumed.
+func (c *checkpoints) discard() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.pending)
+	clear(c.forgotten)
+}
+
+// forget drops the checkpoints of a resource that is no longer a target once
+// the current batch is committed, so persisted state does not grow with
+// resource churn.
+func (c *checkpoints) forget(resourceType string, uuid string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.forgotten[resourceKey(resourceType, uuid)] = struct{}{}
+}
+
+type checkpointState struct {
+	Version int               `json:"version"`
+	Series  []checkpointEntry `json:"series"`
+}
+
+type checkpointEntry struct {
+	ResourceType string    `json:"resource_type"`
+	ResourceUUID string    `json:"resource_uuid"`
+	Metric       string    `json:"metric"`
+	Series       string    `json:"series"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// marshal encodes the committed checkpoints for the storage extension.
+func (c *checkpoints) marshal() ([]byte, error) {
+	c.mu.Lock()
+	state := checkpointState{
+		Version: checkpointStateVersion,
+		Series:  make([]checkpointEntry, 0, len(c.committed)),
+	}
+	for key, ts := range c.committed {
+		state.Series = append(state.Series, checkpointEntry{
+			ResourceType: key.ResourceType,
+			ResourceUUID: key.ResourceUUID,
+			Metric:       key.Metric,
+			Series:       key.Series,
+			Timestamp:    ts,
+		})
+	}
+	c.mu.Unlock()
+	return json.Marshal(state)
+}
+
+// unmarshal replaces the committed checkpoints with persisted state. On error
+// the checkpoints are left untouched.
+func (c *checkpoints) unmarshal(data []byte) error {
+	var state checkpointState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	if state.Version != checkpointStateVersion {
+		return fmt.Errorf("unsupported checkpoint state version %d", state.Version)
+	}
+
+	committed := make(map[seriesKey]time.Time, len(state.Series))
+	for _, entry := range state.Series {
+		if entry.ResourceUUID == "" || entry.Metric == "" || entry.Timestamp.IsZero() {
+			continue
+		}
+		key := seriesKey{
+			ResourceType: entry.ResourceType,
+			ResourceUUID: entry.ResourceUUID,
+			Metric:       entry.Metric,
+			Series:       entry.Series,
+		}
+		if entry.Timestamp.After(committed[key]) {
+			committed[key] = entry.Timestamp.UTC()
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.committed = committed
+	clear(c.pending)
+	clear(c.forgotten)
+	return nil
+}
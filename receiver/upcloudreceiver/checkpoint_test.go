@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+// memStorage is an in-memory storage extension.
+type memStorage struct {
+	component.StartFunc
+	component.ShutdownFunc
+	data map[string][]byte
+}
+
+func (m *memStorage) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return memStorageClient{data: m.data}, nil
+}
+
+type memStorageClient struct {
+	data map[string][]byte
+}
+
+func (c memStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.data[key], nil
+}
+
+func (c memStorageClient) Set(_ context.Context, key string, value []byte) error {
+	c.data[key] = value
+	return nil
+}
+
+func (c memStorageClient) Delete(_ context.Context, key string) error {
+	delete(c.data, key)
+	return nil
+}
+
+func (c memStorageClient) Batch(ctx context.Context, ops ...*storage.Operation) error {
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value, _ = c.Get(ctx, op.Key)
+		case storage.Set:
+			_ = c.Set(ctx, op.Key, op.Value)
+		case storage.Delete:
+			_ = c.Delete(ctx, op.Key)
+		}
+	}
+	return nil
+}
+
+func (memStorageClient) Close(context.Context) error {
+	return nil
+}
+
+func TestCheckpoints_CommitAndDiscard(t *testing.T) {
+	cp := newCheckpoints()
+	key := seriesKey{ResourceType: resourceTypeManagedDatabase, ResourceUUID: "db-uuid", Metric: "cpu_usage", Series: "primary"}
+	ts := time.Date(2026, 2, 21, 8, 0, 0, 0, time.UTC)
+
+	cp.advance(key, ts)
+	if !cp.since(key).IsZero() {
+		t.Fatalf("pending checkpoint must not be visible before commit")
+	}
+	cp.discard()
+	if cp.commit() {
+		t.Fatalf("expected discarded checkpoint not to be committed")
+	}
+
+	cp.advance(key, ts)
+	if !cp.commit() {
+		t.Fatalf("expected commit to report a change")
+	}
+	if got := cp.since(key); !got.Equal(ts) {
+		t.Fatalf("expected checkpoint %s, got %s", ts, got)
+	}
+
+	cp.advance(key, ts.Add(-time.Minute))
+	if cp.commit() {
+		t.Fatalf("expected older timestamp to be ignored")
+	}
+}
+
+func TestCheckpoints_MarshalRoundTrip(t *testing.T) {
+	cp := newCheckpoints()
+	key := seriesKey{ResourceType: resourceTypeManagedLoadBalancer, ResourceUUID: "lb-uuid", Metric: "frontend_usage", Series: "web"}
+	ts := time.Date(2026, 2, 21, 8, 0, 0, 0, time.UTC)
+	cp.advance(key, ts)
+	cp.commit()
+
+	data, err := cp.marshal()
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	restored := newCheckpoints()
+	if err := restored.unmarshal(data); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got := restored.since(key); !got.Equal(ts) {
+		t.Fatalf("expected restored checkpoint %s, got %s", ts, got)
+	}
+}
+
+func TestCheckpoints_UnmarshalRejectsCorruptState(t *testing.T) {
+	key := seriesKey{ResourceType: resourceTypeManagedDatabase, ResourceUUID: "db-uuid", Metric: "cpu_usage", Series: "primary"}
+	ts := time.Date(2026, 2, 21, 8, 0, 0, 0, time.UTC)
+
+	for name, data := range map[string]string{
+		"invalid json":    `{"version":1,"series":[`,
+		"unknown version": `{"version":99,"series":[]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			cp := newCheckpoints()
+			cp.advance(key, ts)
+			cp.commit()
+			if err := cp.unmarshal([]byte(data)); err == nil {
+				t.Fatalf("expected error for corrupt state")
+			}
+			if got := cp.since(key); !got.Equal(ts) {
+				t.Fatalf("expected checkpoints to be left untouched, got %s", got)
+			}
+		})
+	}
+}
+
+func TestReceiver_PersistsCheckpointsAfterConsume(t *testing.T) {
+	storageID := component.MustNewID("file_storage")
+	ext := &memStorage{data: map[string][]byte{
+		checkpointStorageKey: []byte(`{"version":1,"series":[{"resource_type":"managed_database","resource_uuid":"db-uuid","metric":"cpu_usage","series":"primary","timestamp":"2026-02-21T08:00:00Z"}]}`),
+	}}
+	host := extensionsHost{storageID: ext}
+
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Backfill:             true,
+		StorageID:            &storageID,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+	}
+	client := &fakeClient{dbResp: MetricsResponse{
+		"cpu_usage": {
+			Data: MetricsData{
+				Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}},
+				Rows: [][]any{
+					{"2026-02-21T08:00:00Z", 10.0},
+					{"2026-02-21T08:01:00Z", 20.0},
+				},
+			},
+		},
+	}}
+
+	s := &upcloudScraper{
+		cfg:      cfg,
+		settings: receivertest.NewNopSettings(metadata.Type),
+		accounts: []accountScraper{newAccountScraper("", cfg, client, receivertest.NewNopSettings(metadata.Type))},
+	}
+	if err := s.start(context.Background(), host); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+
+	metrics, err := s.scrape(context.Background())
+	if err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	if got := metrics.DataPointCount(); got != 1 {
+		t.Fatalf("expected only the row after the persisted checkpoint, got %d points", got)
+	}
+
+	rejecting, err := consumer.NewMetrics(func(context.Context, pmetric.Metrics) error {
+		return errors.New("downstream unavailable")
+	})
+	if err != nil {
+		t.Fatalf("new consumer: %v", err)
+	}
+	if err := s.consumeFunc(rejecting)(context.Background(), metrics); err == nil {
+		t.Fatalf("expected consume error")
+	}
+	before := string(ext.data[checkpointStorageKey])
+
+	metrics, err = s.scrape(context.Background())
+	if err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	if got := metrics.DataPointCount(); got != 1 {
+		t.Fatalf("expected the rejected row to be emitted again, got %d points", got)
+	}
+	if err := s.consumeFunc(consumertest.NewNop())(context.Background(), metrics); err != nil {
+		t.Fatalf("consume: %v", err)
+	}
+	if after := string(ext.data[checkpointStorageKey]); after == before {
+		t.Fatalf("expected checkpoints to be persisted after a successful consume")
+	}
+
+	restored := newCheckpoints()
+	if err := restored.unmarshal(ext.data[checkpointStorageKey]); err != nil {
+		t.Fatalf("unmarshal persisted state: %v", err)
+	}
+	key := seriesKey{ResourceType: resourceTypeManagedDatabase, ResourceUUID: "db-uuid", Metric: "cpu_usage", Series: "primary"}
+	if got := restored.since(key).Format(time.RFC3339); got != "2026-02-21T08:01:00Z" {
+		t.Fatalf("unexpected persisted checkpoint %s", got)
+	}
+}
+
+func TestReceiver_StartIgnoresCorruptCheckpoints(t *testing.T) {
+	storageID := component.MustNewID("file_storage")
+	host := extensionsHost{storageID: &memStorage{data: map[string][]byte{
+		checkpointStorageKey: []byte("not json"),
+	}}}
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Backfill:             true,
+		StorageID:            &storageID,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+	}
+	s := &upcloudScraper{
+		cfg:      cfg,
+		settings: receivertest.NewNopSettings(metadata.Type),
+		accounts: []accountScraper{newAccountScraper("", cfg, &fakeClient{}, receivertest.NewNopSettings(metadata.Type))},
+	}
+	if err := s.start(context.Background(), host); err != nil {
+		t.Fatalf("expected start to succeed with corrupt state, got %v", err)
+	}
+}
+
+func TestReceiver_StartFailsOnMissingStorageExtension(t *testing.T) {
+	storageID := component.MustNewID("file_storage")
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Backfill:             true,
+		StorageID:            &storageID,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+	}
+	s := &upcloudScraper{
+		cfg:      cfg,
+		settings: receivertest.NewNopSettings(metadata.Type),
+		accounts: []accountScraper{newAccountScraper("", cfg, &fakeClient{}, receivertest.NewNopSettings(metadata.Type))},
+	}
+	if err := s.start(context.Background(), componenttest.NewNopHost()); err == nil {
+		t.Fatalf("expected start to fail when the storage extension is missing")
+	}
+}
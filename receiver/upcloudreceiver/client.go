@@ -6,6 +6,7 @@ package upcloudreceiver
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -15,21 +16,31 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
 )
 
 // Client fetches metrics from UpCloud managed services APIs.
 type Client interface {
-	ListManagedDatabaseServiceUUIDs(ctx context.Context, discoveryPath string, limit int) ([]string, error)
-	ListManagedLoadBalancerUUIDs(ctx context.Context, discoveryPath string) ([]string, error)
+	ListManagedDatabases(ctx context.Context, discoveryPath string, limit int) ([]ResourceInfo, error)
+	ListManagedLoadBalancers(ctx context.Context, discoveryPath string) ([]ResourceInfo, error)
+	// GetManagedDatabase and GetManagedLoadBalancer fetch the details of one
+	// resource from <discoveryPath>/<uuid>.
+	GetManagedDatabase(ctx context.Context, discoveryPath string, uuid string) (ResourceInfo, error)
+	GetManagedLoadBalancer(ctx context.Context, discoveryPath string, uuid string) (ResourceInfo, error)
 	GetManagedDatabaseMetrics(ctx context.Context, uuid string, period string) (MetricsResponse, error)
 	GetManagedLoadBalancerMetrics(ctx context.Context, uuid string, period string) (MetricsResponse, error)
 }
 
 type httpClient struct {
 	baseURL                  *url.URL
-	auth                     requestAuth
+	credentials              *credentialStore
 	client                   *http.Client
+	retry                    RetryConfig
+	limiter                  *rateLimiter
 	loadBalancerPathTemplate string
+	logger                   *zap.Logger
 }
 
 type requestAuth struct {
@@ -38,21 +49,43 @@ type requestAuth struct {
 	password    string
 }
 
-// NewHTTPClient creates a new UpCloud API client.
-func NewHTTPClient(api APIConfig, loadBalancerPathTemplate string) (Client, error) {
+// NewHTTPClient creates a new UpCloud API client. The transport is built from
+// api.ClientConfig, so host must provide any extensions it references.
+func NewHTTPClient(
+	ctx context.Context,
+	api APIConfig,
+	loadBalancerPathTemplate string,
+	host component.Host,
+	settings component.TelemetrySettings,
+) (Client, error) {
 	baseURL, err := url.Parse(strings.TrimRight(api.Endpoint, "/"))
 	if err != nil {
 		return nil, fmt.Errorf("parse api endpoint: %w", err)
 	}
-	auth, err := resolveRequestAuth(api)
+	var extensions map[component.ID]component.Component
+	if host != nil {
+		extensions = host.GetExtensions()
+	}
+	client, err := api.ToClient(ctx, extensions, settings)
+	if err != nil {
+		return nil, fmt.Errorf("create http client: %w", err)
+	}
+	logger := settings.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	credentials, err := newCredentialStore(api, logger)
 	if err != nil {
 		return nil, err
 	}
 	return &httpClient{
 		baseURL:                  baseURL,
-		auth:                     auth,
-		client:                   &http.Client{Timeout: api.Timeout},
+		credentials:              credentials,
+		client:                   client,
+		retry:                    api.Retry,
+		limiter:                  newRateLimiter(api.RequestsPerSecond, api.Burst),
 		loadBalancerPathTemplate: loadBalancerPathTemplate,
+		logger:                   logger,
 	}, nil
 }
 
@@ -68,13 +101,13 @@ func (c *httpClient) GetManagedLoadBalancerMetrics(ctx context.Context, uuid str
 	return c.getMetrics(ctx, endpointPath, period)
 }
 
-func (c *httpClient) ListManagedDatabaseServiceUUIDs(ctx context.Context, discoveryPath string, limit int) ([]string, error) {
+func (c *httpClient) ListManagedDatabases(ctx context.Context, discoveryPath string, limit int) ([]ResourceInfo, error) {
 	if limit <= 0 {
 		limit = defaultDiscoveryLimit
 	}
 
 	seen := map[string]struct{}{}
-	var discovered []string
+	var discovered []ResourceInfo
 	offset := 0
 	for {
 		query := url.Values{}
@@ -86,14 +119,14 @@ func (c *httpClient) ListManagedDatabaseServiceUUIDs(ctx context.Context, discov
 			return nil, err
 		}
 
-		page := extractUUIDs(payload)
+		page := extractResources(payload)
 		newItems := 0
-		for _, id := range page {
-			if _, ok := seen[id]; ok {
+		for _, info := range page {
+			if _, ok := seen[info.UUID]; ok {
 				continue
 			}
-			seen[id] = struct{}{}
-			discovered = append(discovered, id)
+			seen[info.UUID] = struct{}{}
+			discovered = append(discovered, info)
 			newItems++
 		}
 
@@ -103,18 +136,43 @@ func (c *httpClient) ListManagedDatabaseServiceUUIDs(ctx context.Context, discov
 		offset += limit
 	}
 
-	sort.Strings(discovered)
+	sortResources(discovered)
 	return discovered, nil
 }
 
-func (c *httpClient) ListManagedLoadBalancerUUIDs(ctx context.Context, discoveryPath string) ([]string, error) {
+func (c *httpClient) ListManagedLoadBalancers(ctx context.Context, discoveryPath string) ([]ResourceInfo, error) {
 	payload, _, err := c.getJSON(ctx, discoveryPath, nil)
 	if err != nil {
 		return nil, err
 	}
-	ids := extractUUIDs(payload)
-	sort.Strings(ids)
-	return dedupeSorted(ids), nil
+	resources := extractResources(payload)
+	sortResources(resources)
+	return resources, nil
+}
+
+func (c *httpClient) GetManagedDatabase(ctx context.Context, discoveryPath string, uuid string) (ResourceInfo, error) {
+	return c.getResource(ctx, discoveryPath, uuid)
+}
+
+func (c *httpClient) GetManagedLoadBalancer(ctx context.Context, discoveryPath string, uuid string) (ResourceInfo, error) {
+	return c.getResource(ctx, discoveryPath, uuid)
+}
+
+func (c *httpClient) getResource(ctx context.Context, discoveryPath string, uuid string) (ResourceInfo, error) {
+	endpointPath := path.Join(discoveryPath, url.PathEscape(uuid))
+	payload, _, err := c.getJSON(ctx, endpointPath, nil)
+	if err != nil {
+		return ResourceInfo{}, err
+	}
+	obj, ok := payload.(map[string]any)
+	if !ok {
+		return ResourceInfo{}, fmt.Errorf("unexpected payload for %s", endpointPath)
+	}
+	info, ok := parseResourceInfo(obj)
+	if !ok {
+		return ResourceInfo{}, fmt.Errorf("payload for %s has no uuid", endpointPath)
+	}
+	return info, nil
 }
 
 func (c *httpClient) getMetrics(ctx context.Context, endpointPath string, period string) (MetricsResponse, error) {
@@ -155,21 +213,69 @@ func (c *httpClient) getJSON(ctx context.Context, endpointPath string, query url
 		requestURL.RawQuery = query.Encode()
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	maxAttempts := max(c.retry.MaxAttempts, 1)
+	reauthenticated := false
+	for attempt := 1; ; attempt++ {
+		waited, err := c.limiter.wait(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("rate limit wait for %s: %w", endpointPath, err)
+		}
+		if waited > 0 {
+			c.logger.Debug("Throttled UpCloud API request",
+				zap.String("endpoint", endpointPath),
+				zap.Duration("waited", waited),
+			)
+		}
+
+		auth := c.credentials.load()
+		payload, header, err := c.doGetJSON(ctx, requestURL.String(), endpointPath, auth)
+		if err == nil {
+			return payload, header, nil
+		}
+
+		// A rejected token may have been rotated on disk, or already replaced
+		// by a concurrent request; retry once with the current credentials
+		// without consuming a retry attempt.
+		var apiErr *APIError
+		if !reauthenticated && errors.As(err, &apiErr) && apiErr.Kind() == APIErrorKindUnauthorized {
+			reauthenticated = true
+			if c.credentials.renew(auth, "unauthorized") {
+				attempt--
+				continue
+			}
+		}
+
+		retryable, retryAfter := classifyRetry(err)
+		if !retryable || attempt >= maxAttempts {
+			return nil, nil, unwrapTransient(err)
+		}
+		delay := c.retry.backoff(attempt, retryAfter)
+		if waitErr := waitContext(ctx, delay); waitErr != nil {
+			return nil, nil, unwrapTransient(err)
+		}
+	}
+}
+
+func (c *httpClient) doGetJSON(ctx context.Context, requestURL string, endpointPath string, auth requestAuth) (any, http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Accept", "application/json")
-	c.auth.apply(req)
+	auth.apply(req)
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, nil, fmt.Errorf("request %s: %w", endpointPath, err)
+		err = fmt.Errorf("request %s: %w", endpointPath, err)
+		if ctx.Err() == nil && isRetryableTransportError(err) {
+			return nil, nil, &transientError{err: err}
+		}
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, endpointPath)
+		return nil, nil, newAPIError(resp, endpointPath)
 	}
 
 	var payload any
@@ -202,9 +308,15 @@ type MetricsColumn struct {
 	Type  string `json:"type"`
 }
 
-// MetricsHints contains optional display metadata from API.
+// MetricsHints contains optional display metadata from API. Unit and Format
+// are not always reported; together with Title they are used to infer the
+// unit of metric keys the receiver has no descriptor for.
 type MetricsHints struct {
 	Title string `json:"title"`
+	// Unit is the unit of the values, for example "%" or "bytes".
+	Unit string `json:"unit"`
+	// Format is the display format of the values, for example "percent".
+	Format string `json:"format"`
 }
 
 func nowTimestamp(t time.Time) time.Time {
@@ -214,12 +326,16 @@ func nowTimestamp(t time.Time) time.Time {
 	return t.UTC()
 }
 
+// apply sets the configured credentials on req. It is a no-op when requests
+// are authenticated by an api.auth.authenticator extension instead.
 func (a requestAuth) apply(req *http.Request) {
 	if strings.TrimSpace(a.bearerToken) != "" {
 		req.Header.Set("Authorization", "Bearer "+a.bearerToken)
 		return
 	}
-	req.SetBasicAuth(a.username, a.password)
+	if a.username != "" {
+		req.SetBasicAuth(a.username, a.password)
+	}
 }
 
 func resolveRequestAuth(api APIConfig) (requestAuth, error) {
@@ -263,52 +379,6 @@ func resolveSecret(inlineValue string, filePath string, inlineName string, fileN
 	return secret, nil
 }
 
-func extractUUIDs(payload any) []string {
-	switch root := payload.(type) {
-	case []any:
-		return extractUUIDsFromArray(root)
-	case map[string]any:
-		ids := make([]string, 0)
-		if uuid, ok := root["uuid"].(string); ok {
-			ids = append(ids, strings.TrimSpace(uuid))
-		}
-		for _, value := range root {
-			arr, ok := value.([]any)
-			if !ok {
-				continue
-			}
-			ids = append(ids, extractUUIDsFromArray(arr)...)
-		}
-		return dedupe(ids)
-	default:
-		return nil
-	}
-}
-
-func extractUUIDsFromArray(items []any) []string {
-	ids := make([]string, 0, len(items))
-	for _, item := range items {
-		obj, ok := item.(map[string]any)
-		if !ok {
-			continue
-		}
-		raw, ok := obj["uuid"]
-		if !ok {
-			continue
-		}
-		uuid, ok := raw.(string)
-		if !ok {
-			continue
-		}
-		uuid = strings.TrimSpace(uuid)
-		if uuid == "" {
-			continue
-		}
-		ids = append(ids, uuid)
-	}
-	return dedupe(ids)
-}
-
 func dedupe(values []string) []string {
 	seen := make(map[string]struct{}, len(values))
 	out := make([]string, 0, len(values))
@@ -322,20 +392,6 @@ func dedupe(values []string) []string {
 	return out
 }
 
-func dedupeSorted(values []string) []string {
-	if len(values) <= 1 {
-		return values
-	}
-	out := values[:1]
-	for i := 1; i < len(values); i++ {
-		if values[i] == values[i-1] {
-			continue
-		}
-		out = append(out, values[i])
-	}
-	return out
-}
-
 func decodeMetricsResponse(payload any) (MetricsResponse, error) {
 	serialized, err := json.Marshal(payload)
 	if err != nil {
@@ -10,13 +10,20 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
 	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
 )
 
 func TestHTTPClientIntegration_BearerTokenFromFile(t *testing.T) {
@@ -41,11 +48,13 @@ func TestHTTPClientIntegration_BearerTokenFromFile(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := NewHTTPClient(APIConfig{
-		Endpoint:  server.URL,
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+		},
 		TokenFile: tokenFile,
-		Timeout:   2 * time.Second,
-	}, defaultLoadBalancerMetricsTemplate)
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
 	if err != nil {
 		t.Fatalf("new http client: %v", err)
 	}
@@ -55,7 +64,7 @@ func TestHTTPClientIntegration_BearerTokenFromFile(t *testing.T) {
 	}
 }
 
-func TestHTTPClientIntegration_ListManagedDatabaseServiceUUIDs(t *testing.T) {
+func TestHTTPClientIntegration_ListManagedDatabases(t *testing.T) {
 	var calls []string
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/1.3/database" {
@@ -66,8 +75,17 @@ func TestHTTPClientIntegration_ListManagedDatabaseServiceUUIDs(t *testing.T) {
 		switch r.URL.Query().Get("offset") {
 		case "0":
 			_ = json.NewEncoder(w).Encode([]map[string]any{
-				{"uuid": "db-1"},
-				{"uuid": "db-2"},
+				{
+					"uuid":       "db-1",
+					"title":      "payments",
+					"name":       "payments-db",
+					"zone":       "fi-hel2",
+					"plan":       "2x2xCPU-4GB-100GB",
+					"type":       "pg",
+					"state":      "running",
+					"properties": map[string]any{"version": "16"},
+				},
+				{"uuid": "db-2", "name": "orders-db", "type": "mysql"},
 			})
 		default:
 			_ = json.NewEncoder(w).Encode([]map[string]any{})
@@ -75,28 +93,37 @@ func TestHTTPClientIntegration_ListManagedDatabaseServiceUUIDs(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := NewHTTPClient(APIConfig{
-		Endpoint: server.URL,
-		Token:    "fixture-token",
-		Timeout:  2 * time.Second,
-	}, defaultLoadBalancerMetricsTemplate)
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+		},
+		Token: "fixture-token",
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
 	if err != nil {
 		t.Fatalf("new http client: %v", err)
 	}
 
-	ids, err := client.ListManagedDatabaseServiceUUIDs(context.Background(), "/1.3/database", 2)
+	resources, err := client.ListManagedDatabases(context.Background(), "/1.3/database", 2)
 	if err != nil {
-		t.Fatalf("list managed database uuids: %v", err)
+		t.Fatalf("list managed databases: %v", err)
+	}
+	if len(resources) != 2 || resources[0].UUID != "db-1" || resources[1].UUID != "db-2" {
+		t.Fatalf("unexpected discovered resources: %v", resources)
 	}
-	if len(ids) != 2 || ids[0] != "db-1" || ids[1] != "db-2" {
-		t.Fatalf("unexpected discovered ids: %v", ids)
+	want := ResourceInfo{UUID: "db-1", Name: "payments", Zone: "fi-hel2", Plan: "2x2xCPU-4GB-100GB", Type: "pg", Version: "16", State: "running"}
+	if !reflect.DeepEqual(resources[0], want) {
+		t.Fatalf("unexpected details of db-1:\n got %+v\nwant %+v", resources[0], want)
+	}
+	if resources[1].Name != "orders-db" {
+		t.Fatalf("expected the name when there is no title, got %q", resources[1].Name)
 	}
 	if len(calls) != 2 {
 		t.Fatalf("expected two paginated calls, got %d", len(calls))
 	}
 }
 
-func TestHTTPClientIntegration_ListManagedLoadBalancerUUIDs(t *testing.T) {
+func TestHTTPClientIntegration_ListManagedLoadBalancers(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/1.3/load-balancer" {
 			t.Fatalf("unexpected path: %q", r.URL.Path)
@@ -104,28 +131,71 @@ func TestHTTPClientIntegration_ListManagedLoadBalancerUUIDs(t *testing.T) {
 		w.Header().Set("Content-Type", "application/json")
 		_ = json.NewEncoder(w).Encode(map[string]any{
 			"load_balancers": []map[string]any{
-				{"uuid": "lb-2"},
+				{"uuid": "lb-2", "name": "edge", "zone": "de-fra1", "operational_state": "running"},
 				{"uuid": "lb-1"},
 			},
 		})
 	}))
 	defer server.Close()
 
-	client, err := NewHTTPClient(APIConfig{
-		Endpoint: server.URL,
-		Token:    "fixture-token",
-		Timeout:  2 * time.Second,
-	}, defaultLoadBalancerMetricsTemplate)
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+		},
+		Token: "fixture-token",
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		t.Fatalf("new http client: %v", err)
+	}
+
+	resources, err := client.ListManagedLoadBalancers(context.Background(), "/1.3/load-balancer")
+	if err != nil {
+		t.Fatalf("list managed load balancers: %v", err)
+	}
+	if len(resources) != 2 || resources[0].UUID != "lb-1" || resources[1].UUID != "lb-2" {
+		t.Fatalf("unexpected discovered resources: %v", resources)
+	}
+	if got := resources[1]; got.Name != "edge" || got.Zone != "de-fra1" || got.State != "running" {
+		t.Fatalf("unexpected details of lb-2: %+v", got)
+	}
+}
+
+func TestHTTPClientIntegration_GetManagedDatabase(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/1.3/database/db-1" {
+			t.Fatalf("unexpected path: %q", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"uuid":       "db-1",
+			"title":      "payments",
+			"zone":       "fi-hel2",
+			"type":       "valkey",
+			"state":      "running",
+			"properties": map[string]any{"version": "8"},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+		},
+		Token: "fixture-token",
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
 	if err != nil {
 		t.Fatalf("new http client: %v", err)
 	}
 
-	ids, err := client.ListManagedLoadBalancerUUIDs(context.Background(), "/1.3/load-balancer")
+	info, err := client.GetManagedDatabase(context.Background(), "/1.3/database", "db-1")
 	if err != nil {
-		t.Fatalf("list managed load balancer uuids: %v", err)
+		t.Fatalf("get managed database: %v", err)
 	}
-	if len(ids) != 2 || ids[0] != "lb-1" || ids[1] != "lb-2" {
-		t.Fatalf("unexpected discovered ids: %v", ids)
+	want := ResourceInfo{UUID: "db-1", Name: "payments", Zone: "fi-hel2", Type: "valkey", Version: "8", State: "running"}
+	if !reflect.DeepEqual(info, want) {
+		t.Fatalf("unexpected details:\n got %+v\nwant %+v", info, want)
 	}
 }
 
@@ -156,11 +226,13 @@ func TestHTTPClientIntegration_LoadBalancerSnapshotConversion(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := NewHTTPClient(APIConfig{
-		Endpoint: server.URL,
-		Token:    "fixture-token",
-		Timeout:  2 * time.Second,
-	}, "/1.3/load-balancer/{uuid}/metrics")
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+		},
+		Token: "fixture-token",
+	}, "/1.3/load-balancer/{uuid}/metrics", componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
 	if err != nil {
 		t.Fatalf("new http client: %v", err)
 	}
@@ -207,12 +279,14 @@ func TestHTTPClientIntegration_BasicAuthFromPasswordFile(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := NewHTTPClient(APIConfig{
-		Endpoint:     server.URL,
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+		},
 		Username:     "fixture-user",
 		PasswordFile: passwordFile,
-		Timeout:      2 * time.Second,
-	}, "/1.3/load-balancer/{uuid}/metrics")
+	}, "/1.3/load-balancer/{uuid}/metrics", componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
 	if err != nil {
 		t.Fatalf("new http client: %v", err)
 	}
@@ -240,12 +314,13 @@ func TestScrapeMetricsIntegration_DatabaseAndLoadBalancer(t *testing.T) {
 	defer server.Close()
 
 	cfg := &Config{
-		CollectionInterval: 10 * time.Second,
-		InitialDelay:       0,
+		ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 10 * time.Second, InitialDelay: 0},
 		API: APIConfig{
-			Endpoint: server.URL,
-			Token:    "fixture-token",
-			Timeout:  2 * time.Second,
+			ClientConfig: confighttp.ClientConfig{
+				Endpoint: server.URL,
+				Timeout:  2 * time.Second,
+			},
+			Token: "fixture-token",
 		},
 		ManagedDatabases: ManagedDatabaseConfig{
 			Enabled: true,
@@ -260,12 +335,12 @@ func TestScrapeMetricsIntegration_DatabaseAndLoadBalancer(t *testing.T) {
 		},
 	}
 
-	client, err := NewHTTPClient(cfg.API, cfg.ManagedLoadBalancers.MetricsPathTemplate)
+	client, err := NewHTTPClient(context.Background(), cfg.API, cfg.ManagedLoadBalancers.MetricsPathTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
 	if err != nil {
 		t.Fatalf("new http client: %v", err)
 	}
 
-	metrics, err := scrapeMetrics(context.Background(), client, cfg, zap.NewNop())
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
 	if err != nil {
 		t.Fatalf("scrape metrics: %v", err)
 	}
@@ -320,12 +395,13 @@ func TestScrapeMetricsIntegration_AutoDiscover(t *testing.T) {
 	defer server.Close()
 
 	cfg := &Config{
-		CollectionInterval: 10 * time.Second,
-		InitialDelay:       0,
+		ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 10 * time.Second, InitialDelay: 0},
 		API: APIConfig{
-			Endpoint: server.URL,
-			Token:    "fixture-token",
-			Timeout:  2 * time.Second,
+			ClientConfig: confighttp.ClientConfig{
+				Endpoint: server.URL,
+				Timeout:  2 * time.Second,
+			},
+			Token: "fixture-token",
 		},
 		ManagedDatabases: ManagedDatabaseConfig{
 			Enabled:        true,
@@ -343,12 +419,12 @@ func TestScrapeMetricsIntegration_AutoDiscover(t *testing.T) {
 		},
 	}
 
-	client, err := NewHTTPClient(cfg.API, cfg.ManagedLoadBalancers.MetricsPathTemplate)
+	client, err := NewHTTPClient(context.Background(), cfg.API, cfg.ManagedLoadBalancers.MetricsPathTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
 	if err != nil {
 		t.Fatalf("new http client: %v", err)
 	}
 
-	metrics, err := scrapeMetrics(context.Background(), client, cfg, zap.NewNop())
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
 	if err != nil {
 		t.Fatalf("scrape metrics: %v", err)
 	}
@@ -360,11 +436,13 @@ func TestScrapeMetricsIntegration_AutoDiscover(t *testing.T) {
 }
 
 func TestNewHTTPClient_InvalidCredentialFile(t *testing.T) {
-	_, err := NewHTTPClient(APIConfig{
-		Endpoint:  "https://api.upcloud.com",
+	_, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: "https://api.upcloud.com",
+			Timeout:  2 * time.Second,
+		},
 		TokenFile: "/non-existent/token",
-		Timeout:   2 * time.Second,
-	}, defaultLoadBalancerMetricsTemplate)
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
 	if err == nil {
 		t.Fatalf("expected error for missing credential file")
 	}
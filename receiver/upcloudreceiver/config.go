@@ -6,10 +6,19 @@ package upcloudreceiver
 import (
 	"fmt"
 	"net/url"
+	"path"
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
 )
 
 const (
@@ -17,56 +26,195 @@ const (
 	defaultCollectionInterval           = 60 * time.Second
 	defaultInitialDelay                 = 1 * time.Second
 	defaultAPITimeout                   = 10 * time.Second
+	defaultMaxConcurrency               = 4
 	defaultManagedDatabasePeriod        = "hour"
 	defaultManagedLoadBalancerPeriod    = "hour"
 	defaultManagedDatabaseDiscovery     = "/1.3/database"
 	defaultManagedLoadBalancerDiscovery = "/1.3/load-balancer"
 	defaultDiscoveryLimit               = 100
+	defaultDetailsRefreshInterval       = 1 * time.Hour
 	defaultLoadBalancerMetricsTemplate  = "/1.3/load-balancer/{uuid}/metrics"
+	defaultCredentialsRefresh           = 1 * time.Minute
+	defaultRetryMaxAttempts             = 3
+	defaultRetryInitialBackoff          = 1 * time.Second
+	defaultRetryMaxBackoff              = 30 * time.Second
+	defaultRetryJitter                  = 0.2
+	defaultLabelPrefix                  = "upcloud.label."
 )
 
 // Config defines the upcloud receiver settings.
 type Config struct {
-	CollectionInterval   time.Duration             `mapstructure:"collection_interval"`
-	InitialDelay         time.Duration             `mapstructure:"initial_delay"`
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+
+	MaxConcurrency       int                       `mapstructure:"max_concurrency"`
 	API                  APIConfig                 `mapstructure:"api"`
 	ManagedDatabases     ManagedDatabaseConfig     `mapstructure:"managed_databases"`
 	ManagedLoadBalancers ManagedLoadBalancerConfig `mapstructure:"managed_load_balancers"`
+	Accounts             []AccountConfig           `mapstructure:"accounts"`
+	// DiscoveryInterval is how long discovered UUIDs are cached; 0 discovers on every scrape.
+	DiscoveryInterval time.Duration `mapstructure:"discovery_interval"`
+	// DetailsRefreshInterval is how long the details of explicitly configured
+	// UUIDs are cached; 0 looks them up on every scrape.
+	DetailsRefreshInterval time.Duration `mapstructure:"details_refresh_interval"`
+	// Backfill emits every row of the requested period that is newer than the
+	// last exported row of its series, instead of only the latest row.
+	Backfill bool `mapstructure:"backfill"`
+	// StorageID names a storage extension used to persist backfill
+	// checkpoints across restarts.
+	StorageID *component.ID  `mapstructure:"storage"`
+	Schedule  ScheduleConfig `mapstructure:"schedule"`
+	// CounterRates adds a <name>.rate gauge with the per-second increase of
+	// every cumulative counter.
+	CounterRates bool `mapstructure:"counter_rates"`
+	// KeepSeriesLabel keeps the raw upcloud.series column label on database
+	// data points next to the parsed upcloud.node.role and upcloud.node.name.
+	KeepSeriesLabel bool `mapstructure:"keep_series_label"`
+	// Labels is read from resource_attributes::labels by Unmarshal, next to
+	// the generated resource attribute toggles.
+	Labels LabelsConfig `mapstructure:"-"`
+}
+
+// LabelsConfig selects the UpCloud labels that are added as resource
+// attributes named <prefix><key>.
+type LabelsConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Include lists glob patterns of label keys to add; empty adds every label.
+	Include []string `mapstructure:"include"`
+	// Exclude lists glob patterns of label keys to leave out, applied after Include.
+	Exclude []string `mapstructure:"exclude"`
+	Prefix  string   `mapstructure:"prefix"`
+}
+
+// ScheduleConfig controls when the resources of a scrape are fetched.
+type ScheduleConfig struct {
+	// Mode is burst (fetch every resource at the start of the scrape) or
+	// spread (fetch each resource at a stable offset within the interval).
+	Mode string `mapstructure:"mode"`
+	// Jitter is the random delay added to each offset in spread mode.
+	Jitter time.Duration `mapstructure:"jitter"`
 }
 
-// APIConfig defines authentication and endpoint settings.
+// APIConfig defines authentication and endpoint settings. Transport settings
+// (endpoint, timeout, TLS, proxy, headers, compression, connection pooling)
+// come from the embedded confighttp.ClientConfig; the UpCloud credentials are
+// applied on top of every request.
 type APIConfig struct {
-	Endpoint     string              `mapstructure:"endpoint"`
+	confighttp.ClientConfig `mapstructure:",squash"`
+
 	Token        configopaque.String `mapstructure:"token"`
 	TokenFile    string              `mapstructure:"token_file"`
 	Username     string              `mapstructure:"username"`
 	Password     configopaque.String `mapstructure:"password"`
 	PasswordFile string              `mapstructure:"password_file"`
-	Timeout      time.Duration       `mapstructure:"timeout"`
+	Retry        RetryConfig         `mapstructure:"retry"`
+	// CredentialsRefreshInterval controls how often token_file and password_file
+	// are re-read; 0 re-reads them only after a 401 response.
+	CredentialsRefreshInterval time.Duration `mapstructure:"credentials_refresh_interval"`
+	// RequestsPerSecond caps the request rate of discovery and metrics calls; 0 disables limiting.
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+	// Burst is the number of requests allowed above the steady rate; defaults to ceil(requests_per_second).
+	Burst int `mapstructure:"burst"`
+}
+
+// RetryConfig defines how transient API failures (429, 5xx, connection resets) are retried.
+type RetryConfig struct {
+	// MaxAttempts is the total number of attempts per request; 0 or 1 disables retries.
+	MaxAttempts    int           `mapstructure:"max_attempts"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+	// Jitter is the random fraction (0..1) applied to each backoff delay.
+	Jitter float64 `mapstructure:"jitter"`
+}
+
+// AccountConfig scrapes one UpCloud account. Credentials left empty fall back
+// to the top-level api block; all other api settings are shared. Resource
+// overrides are applied on top of the top-level resource blocks.
+type AccountConfig struct {
+	Name         string              `mapstructure:"name"`
+	Token        configopaque.String `mapstructure:"token"`
+	TokenFile    string              `mapstructure:"token_file"`
+	Username     string              `mapstructure:"username"`
+	Password     configopaque.String `mapstructure:"password"`
+	PasswordFile string              `mapstructure:"password_file"`
+	// Auth selects an authenticator extension for this account instead of
+	// inline credentials.
+	Auth                 configoptional.Optional[configauth.Config] `mapstructure:"auth"`
+	ManagedDatabases     AccountResourceConfig                      `mapstructure:"managed_databases"`
+	ManagedLoadBalancers AccountResourceConfig                      `mapstructure:"managed_load_balancers"`
+}
+
+// AccountResourceConfig overrides selected fields of a resource block for one
+// account. Unset fields keep the top-level value.
+type AccountResourceConfig struct {
+	Enabled      *bool    `mapstructure:"enabled"`
+	UUIDs        []string `mapstructure:"uuids"`
+	AutoDiscover *bool    `mapstructure:"auto_discover"`
+	ExcludeUUIDs []string `mapstructure:"exclude_uuids"`
+	// Selector replaces the selector of the top-level block.
+	Selector *SelectorConfig `mapstructure:"selector"`
+	Period   string          `mapstructure:"period"`
+	Metrics  []string        `mapstructure:"metrics"`
+}
+
+// SelectorConfig keeps only the discovered resources that match every rule
+// that is set. Explicit uuids are always scraped.
+type SelectorConfig struct {
+	// Labels requires each label to have exactly the given value.
+	Labels map[string]string `mapstructure:"labels"`
+	// LabelRegex requires each label to match the given regular expression
+	// in full.
+	LabelRegex map[string]string `mapstructure:"label_regex"`
+	// Zones lists the zones to keep, e.g. fi-hel2.
+	Zones []string `mapstructure:"zones"`
+	// Names lists glob patterns matched against the resource name.
+	Names []string `mapstructure:"names"`
+	// States lists the states to keep, e.g. running.
+	States []string `mapstructure:"states"`
 }
 
 // ManagedDatabaseConfig configures database metrics scraping.
 type ManagedDatabaseConfig struct {
-	Enabled        bool     `mapstructure:"enabled"`
-	UUIDs          []string `mapstructure:"uuids"`
-	AutoDiscover   bool     `mapstructure:"auto_discover"`
-	DiscoveryPath  string   `mapstructure:"discovery_path"`
-	DiscoveryLimit int      `mapstructure:"discovery_limit"`
-	ExcludeUUIDs   []string `mapstructure:"exclude_uuids"`
-	Period         string   `mapstructure:"period"`
-	Metrics        []string `mapstructure:"metrics"`
+	Enabled        bool           `mapstructure:"enabled"`
+	UUIDs          []string       `mapstructure:"uuids"`
+	AutoDiscover   bool           `mapstructure:"auto_discover"`
+	DiscoveryPath  string         `mapstructure:"discovery_path"`
+	DiscoveryLimit int            `mapstructure:"discovery_limit"`
+	ExcludeUUIDs   []string       `mapstructure:"exclude_uuids"`
+	Selector       SelectorConfig `mapstructure:"selector"`
+	Period         string         `mapstructure:"period"`
+	Metrics        []string       `mapstructure:"metrics"`
+	// MetricOverrides defines how UpCloud metric keys are emitted, taking
+	// precedence over the built-in descriptors.
+	MetricOverrides map[string]MetricOverrideConfig `mapstructure:"metric_overrides"`
 }
 
 // ManagedLoadBalancerConfig configures load balancer metrics scraping.
 type ManagedLoadBalancerConfig struct {
-	Enabled             bool     `mapstructure:"enabled"`
-	UUIDs               []string `mapstructure:"uuids"`
-	AutoDiscover        bool     `mapstructure:"auto_discover"`
-	DiscoveryPath       string   `mapstructure:"discovery_path"`
-	ExcludeUUIDs        []string `mapstructure:"exclude_uuids"`
-	Period              string   `mapstructure:"period"`
-	Metrics             []string `mapstructure:"metrics"`
-	MetricsPathTemplate string   `mapstructure:"metrics_path_template"`
+	Enabled             bool           `mapstructure:"enabled"`
+	UUIDs               []string       `mapstructure:"uuids"`
+	AutoDiscover        bool           `mapstructure:"auto_discover"`
+	DiscoveryPath       string         `mapstructure:"discovery_path"`
+	ExcludeUUIDs        []string       `mapstructure:"exclude_uuids"`
+	Selector            SelectorConfig `mapstructure:"selector"`
+	Period              string         `mapstructure:"period"`
+	Metrics             []string       `mapstructure:"metrics"`
+	MetricsPathTemplate string         `mapstructure:"metrics_path_template"`
+	// MetricOverrides defines how UpCloud metric keys are emitted, taking
+	// precedence over the built-in descriptors.
+	MetricOverrides map[string]MetricOverrideConfig `mapstructure:"metric_overrides"`
+}
+
+// MetricOverrideConfig defines the metric emitted for one UpCloud metric key.
+type MetricOverrideConfig struct {
+	Name string `mapstructure:"name"`
+	// Unit defaults to 1.
+	Unit        string `mapstructure:"unit"`
+	Description string `mapstructure:"description"`
+	// PercentToRatio divides values by 100.
+	PercentToRatio bool `mapstructure:"percent_to_ratio"`
+	// Type is gauge (default) or sum, a monotonic cumulative counter.
+	Type string `mapstructure:"type"`
 }
 
 // Validate validates receiver configuration.
@@ -77,26 +225,86 @@ func (cfg *Config) Validate() error {
 	if cfg.InitialDelay < 0 {
 		return fmt.Errorf("initial_delay must be >= 0")
 	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be >= 0")
+	}
+	if cfg.MaxConcurrency < 0 {
+		return fmt.Errorf("max_concurrency must be >= 0")
+	}
+	if cfg.DiscoveryInterval < 0 {
+		return fmt.Errorf("discovery_interval must be >= 0")
+	}
+	if cfg.DetailsRefreshInterval < 0 {
+		return fmt.Errorf("details_refresh_interval must be >= 0")
+	}
+	if cfg.StorageID != nil && !cfg.Backfill {
+		return fmt.Errorf("storage requires backfill=true")
+	}
+	if err := cfg.validateSchedule(); err != nil {
+		return err
+	}
+	if err := cfg.Labels.Validate(); err != nil {
+		return err
+	}
 	if strings.TrimSpace(cfg.API.Endpoint) == "" {
 		return fmt.Errorf("api.endpoint is required")
 	}
 	if _, err := url.ParseRequestURI(cfg.API.Endpoint); err != nil {
 		return fmt.Errorf("api.endpoint is invalid: %w", err)
 	}
-	if err := cfg.API.Validate(); err != nil {
-		return err
-	}
 	if cfg.API.Timeout <= 0 {
 		return fmt.Errorf("api.timeout must be > 0")
 	}
+	if cfg.API.CredentialsRefreshInterval < 0 {
+		return fmt.Errorf("api.credentials_refresh_interval must be >= 0")
+	}
+	if cfg.API.RequestsPerSecond < 0 {
+		return fmt.Errorf("api.requests_per_second must be >= 0")
+	}
+	if cfg.API.Burst < 0 {
+		return fmt.Errorf("api.burst must be >= 0")
+	}
+	if err := cfg.API.Retry.Validate(); err != nil {
+		return err
+	}
+	if len(cfg.Accounts) == 0 {
+		if err := cfg.API.Validate(); err != nil {
+			return err
+		}
+		return cfg.validateResources()
+	}
+
+	seen := make(map[string]struct{}, len(cfg.Accounts))
+	for idx, account := range cfg.Accounts {
+		name := strings.TrimSpace(account.Name)
+		if name == "" {
+			return fmt.Errorf("accounts[%d].name is required", idx)
+		}
+		if _, dup := seen[name]; dup {
+			return fmt.Errorf("accounts[%d].name %q is duplicated", idx, name)
+		}
+		seen[name] = struct{}{}
+
+		effective := cfg.forAccount(account)
+		if err := effective.API.Validate(); err != nil {
+			return fmt.Errorf("account %q: %w", name, err)
+		}
+		if err := effective.validateResources(); err != nil {
+			return fmt.Errorf("account %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (cfg *Config) validateResources() error {
 	if !cfg.ManagedDatabases.Enabled && !cfg.ManagedLoadBalancers.Enabled {
 		return fmt.Errorf("at least one managed service block must be enabled")
 	}
 	if cfg.ManagedDatabases.Enabled && len(cfg.ManagedDatabases.UUIDs) == 0 && !cfg.ManagedDatabases.AutoDiscover {
 		return fmt.Errorf("managed_databases requires uuids or auto_discover=true")
 	}
-	if cfg.ManagedDatabases.Enabled && !isValidManagedDatabasePeriod(cfg.ManagedDatabases.Period) {
-		return fmt.Errorf("managed_databases.period must be one of: hour, day, week, month, year")
+	if cfg.ManagedDatabases.Enabled && !isValidMetricsPeriod(cfg.ManagedDatabases.Period) {
+		return fmt.Errorf("managed_databases.period must be one of: auto, hour, day, week, month, year")
 	}
 	if cfg.ManagedDatabases.AutoDiscover && strings.TrimSpace(cfg.ManagedDatabases.DiscoveryPath) == "" {
 		return fmt.Errorf("managed_databases.discovery_path is required when auto_discover=true")
@@ -110,19 +318,106 @@ func (cfg *Config) Validate() error {
 	if cfg.ManagedLoadBalancers.AutoDiscover && strings.TrimSpace(cfg.ManagedLoadBalancers.DiscoveryPath) == "" {
 		return fmt.Errorf("managed_load_balancers.discovery_path is required when auto_discover=true")
 	}
+	if cfg.ManagedLoadBalancers.Enabled && !isValidMetricsPeriod(cfg.ManagedLoadBalancers.Period) {
+		return fmt.Errorf("managed_load_balancers.period must be one of: auto, hour, day, week, month, year")
+	}
 	if cfg.ManagedLoadBalancers.Enabled && !strings.Contains(cfg.ManagedLoadBalancers.MetricsPathTemplate, "{uuid}") {
 		return fmt.Errorf("managed_load_balancers.metrics_path_template must contain {uuid}")
 	}
+	if err := validateMetricOverrides(resourceTypeManagedDatabase, cfg.ManagedDatabases.MetricOverrides); err != nil {
+		return fmt.Errorf("managed_databases.%w", err)
+	}
+	if err := validateMetricOverrides(resourceTypeManagedLoadBalancer, cfg.ManagedLoadBalancers.MetricOverrides); err != nil {
+		return fmt.Errorf("managed_load_balancers.%w", err)
+	}
+	if err := cfg.ManagedDatabases.Selector.validate(cfg.ManagedDatabases.AutoDiscover); err != nil {
+		return fmt.Errorf("managed_databases.selector: %w", err)
+	}
+	if err := cfg.ManagedLoadBalancers.Selector.validate(cfg.ManagedLoadBalancers.AutoDiscover); err != nil {
+		return fmt.Errorf("managed_load_balancers.selector: %w", err)
+	}
+	return nil
+}
+
+// isSet reports whether any selector rule is configured.
+func (cfg SelectorConfig) isSet() bool {
+	return len(cfg.Labels) > 0 || len(cfg.LabelRegex) > 0 || len(cfg.Zones) > 0 || len(cfg.Names) > 0 || len(cfg.States) > 0
+}
+
+func (cfg SelectorConfig) validate(autoDiscover bool) error {
+	if !cfg.isSet() {
+		return nil
+	}
+	if !autoDiscover {
+		return fmt.Errorf("requires auto_discover=true")
+	}
+	_, err := newResourceSelector(cfg)
+	return err
+}
+
+func (cfg *Config) validateSchedule() error {
+	if cfg.Schedule.Jitter < 0 {
+		return fmt.Errorf("schedule.jitter must be >= 0")
+	}
+	switch strings.TrimSpace(strings.ToLower(cfg.Schedule.Mode)) {
+	case "", scheduleModeBurst:
+		return nil
+	case scheduleModeSpread:
+		if spreadWindow(cfg) <= 0 {
+			return fmt.Errorf("schedule.mode spread requires collection_interval and timeout to be greater than api.timeout")
+		}
+		return nil
+	default:
+		return fmt.Errorf("schedule.mode must be one of: burst, spread")
+	}
+}
+
+// Validate validates the label selection.
+func (cfg *LabelsConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if strings.TrimSpace(cfg.Prefix) == "" {
+		return fmt.Errorf("resource_attributes.labels.prefix must not be empty")
+	}
+	for _, pattern := range append(append([]string(nil), cfg.Include...), cfg.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("resource_attributes.labels pattern %q is invalid: %w", pattern, err)
+		}
+	}
 	return nil
 }
 
-func isValidManagedDatabasePeriod(period string) bool {
+// Unmarshal decodes the configuration. resource_attributes::labels is not a
+// generated resource attribute toggle, so it is decoded into Labels and
+// removed before the rest of the configuration is decoded.
+func (cfg *Config) Unmarshal(conf *confmap.Conf) error {
+	raw := conf.ToStringMap()
+	if attrs, ok := raw["resource_attributes"].(map[string]any); ok {
+		if labels, ok := attrs["labels"]; ok {
+			delete(attrs, "labels")
+			labelsMap, ok := labels.(map[string]any)
+			if !ok && labels != nil {
+				return fmt.Errorf("resource_attributes::labels must be a map")
+			}
+			if err := confmap.NewFromStringMap(labelsMap).Unmarshal(&cfg.Labels); err != nil {
+				return fmt.Errorf("resource_attributes::labels: %w", err)
+			}
+		}
+	}
+
+	// plain has the fields of Config without its Unmarshal method.
+	type plain Config
+	return confmap.NewFromStringMap(raw).Unmarshal((*plain)(cfg))
+}
+
+func isValidMetricsPeriod(period string) bool {
 	normalized := strings.TrimSpace(strings.ToLower(period))
 	if normalized == "" {
 		return true
 	}
 	switch normalized {
-	case "hour", "day", "week", "month", "year":
+	case periodAuto, "hour", "day", "week", "month", "year":
 		return true
 	default:
 		return false
@@ -140,6 +435,8 @@ func (cfg *APIConfig) Validate() error {
 	hasPasswordFile := strings.TrimSpace(cfg.PasswordFile) != ""
 	hasBasic := hasUsername || hasPassword || hasPasswordFile
 
+	hasAuthenticator := cfg.Auth.HasValue()
+
 	if hasToken && hasTokenFile {
 		return fmt.Errorf("api.token and api.token_file are mutually exclusive")
 	}
@@ -149,8 +446,11 @@ func (cfg *APIConfig) Validate() error {
 	if hasBearer && hasBasic {
 		return fmt.Errorf("bearer auth (token/token_file) and basic auth (username/password) are mutually exclusive")
 	}
-	if !hasBearer && !hasBasic {
-		return fmt.Errorf("api authentication is required: set token/token_file or username+password")
+	if hasAuthenticator && (hasBearer || hasBasic) {
+		return fmt.Errorf("api.auth.authenticator is mutually exclusive with token/token_file and username/password")
+	}
+	if !hasBearer && !hasBasic && !hasAuthenticator {
+		return fmt.Errorf("api authentication is required: set token/token_file, username+password or auth.authenticator")
 	}
 	if hasBasic {
 		if !hasUsername {
@@ -162,3 +462,23 @@ func (cfg *APIConfig) Validate() error {
 	}
 	return nil
 }
+
+// Validate validates retry configuration.
+func (cfg *RetryConfig) Validate() error {
+	if cfg.MaxAttempts < 0 {
+		return fmt.Errorf("api.retry.max_attempts must be >= 0")
+	}
+	if cfg.InitialBackoff < 0 {
+		return fmt.Errorf("api.retry.initial_backoff must be >= 0")
+	}
+	if cfg.MaxBackoff < 0 {
+		return fmt.Errorf("api.retry.max_backoff must be >= 0")
+	}
+	if cfg.MaxBackoff > 0 && cfg.MaxBackoff < cfg.InitialBackoff {
+		return fmt.Errorf("api.retry.max_backoff must be >= api.retry.initial_backoff")
+	}
+	if cfg.Jitter < 0 || cfg.Jitter > 1 {
+		return fmt.Errorf("api.retry.jitter must be between 0 and 1")
+	}
+	return nil
+}
@@ -3,7 +3,18 @@
 
 package upcloudreceiver
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+)
 
 func TestConfigValidate(t *testing.T) {
 	tests := []struct {
@@ -14,12 +25,13 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "valid database config",
 			cfg: Config{
-				CollectionInterval: 30,
-				InitialDelay:       1,
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30, InitialDelay: 1},
 				API: APIConfig{
-					Endpoint: "https://api.upcloud.com",
-					Token:    "token",
-					Timeout:  10,
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+					},
+					Token: "token",
 				},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
@@ -33,12 +45,13 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "valid auto discover database config",
 			cfg: Config{
-				CollectionInterval: 30,
-				InitialDelay:       1,
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30, InitialDelay: 1},
 				API: APIConfig{
-					Endpoint: "https://api.upcloud.com",
-					Token:    "token",
-					Timeout:  10,
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+					},
+					Token: "token",
 				},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
@@ -52,12 +65,13 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "valid token file auth",
 			cfg: Config{
-				CollectionInterval: 30,
-				InitialDelay:       1,
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30, InitialDelay: 1},
 				API: APIConfig{
-					Endpoint:  "https://api.upcloud.com",
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+					},
 					TokenFile: "/tmp/upcloud-token",
-					Timeout:   10,
 				},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
@@ -71,13 +85,14 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "valid basic auth",
 			cfg: Config{
-				CollectionInterval: 30,
-				InitialDelay:       1,
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30, InitialDelay: 1},
 				API: APIConfig{
-					Endpoint: "https://api.upcloud.com",
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+					},
 					Username: "user",
 					Password: "pass",
-					Timeout:  10,
 				},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
@@ -88,13 +103,46 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid authenticator extension",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API: APIConfig{
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+						Auth:     configoptional.Some(configauth.Config{AuthenticatorID: component.MustNewID("bearertokenauth")}),
+					},
+				},
+				ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid authenticator extension with token",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API: APIConfig{
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+						Auth:     configoptional.Some(configauth.Config{AuthenticatorID: component.MustNewID("bearertokenauth")}),
+					},
+					Token: "token",
+				},
+				ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+			},
+			wantErr: true,
+		},
 		{
 			name: "missing token",
 			cfg: Config{
-				CollectionInterval: 30,
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
 				API: APIConfig{
-					Endpoint: "https://api.upcloud.com",
-					Timeout:  10,
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+					},
 				},
 				ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
 			},
@@ -103,12 +151,14 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "invalid both token and token file",
 			cfg: Config{
-				CollectionInterval: 30,
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
 				API: APIConfig{
-					Endpoint:  "https://api.upcloud.com",
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+					},
 					Token:     "token",
 					TokenFile: "/tmp/upcloud-token",
-					Timeout:   10,
 				},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
@@ -122,13 +172,15 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "invalid bearer and basic mixed",
 			cfg: Config{
-				CollectionInterval: 30,
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
 				API: APIConfig{
-					Endpoint: "https://api.upcloud.com",
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+					},
 					Token:    "token",
 					Username: "user",
 					Password: "pass",
-					Timeout:  10,
 				},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
@@ -142,11 +194,13 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "invalid basic missing username",
 			cfg: Config{
-				CollectionInterval: 30,
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
 				API: APIConfig{
-					Endpoint: "https://api.upcloud.com",
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+					},
 					Password: "pass",
-					Timeout:  10,
 				},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
@@ -160,8 +214,8 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "enabled database without uuids",
 			cfg: Config{
-				CollectionInterval: 30,
-				API:                APIConfig{Endpoint: "https://api.upcloud.com", Token: "token", Timeout: 10},
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
 					DiscoveryPath:  defaultManagedDatabaseDiscovery,
@@ -173,8 +227,8 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "auto discover database missing discovery path",
 			cfg: Config{
-				CollectionInterval: 30,
-				API:                APIConfig{Endpoint: "https://api.upcloud.com", Token: "token", Timeout: 10},
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
 					AutoDiscover:   true,
@@ -186,8 +240,8 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "auto discover database invalid limit",
 			cfg: Config{
-				CollectionInterval: 30,
-				API:                APIConfig{Endpoint: "https://api.upcloud.com", Token: "token", Timeout: 10},
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
 					AutoDiscover:   true,
@@ -200,8 +254,8 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "invalid managed database period",
 			cfg: Config{
-				CollectionInterval: 30,
-				API:                APIConfig{Endpoint: "https://api.upcloud.com", Token: "token", Timeout: 10},
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
 				ManagedDatabases: ManagedDatabaseConfig{
 					Enabled:        true,
 					AutoDiscover:   true,
@@ -215,8 +269,8 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "invalid load balancer template",
 			cfg: Config{
-				CollectionInterval: 30,
-				API:                APIConfig{Endpoint: "https://api.upcloud.com", Token: "token", Timeout: 10},
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
 				ManagedLoadBalancers: ManagedLoadBalancerConfig{
 					Enabled:             true,
 					UUIDs:               []string{"lb-uuid"},
@@ -230,9 +284,9 @@ func TestConfigValidate(t *testing.T) {
 		{
 			name: "valid auto discover load balancer config",
 			cfg: Config{
-				CollectionInterval: 30,
-				API:                APIConfig{Endpoint: "https://api.upcloud.com", Token: "token", Timeout: 10},
-				ManagedDatabases:   ManagedDatabaseConfig{Enabled: false},
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
+				ManagedDatabases: ManagedDatabaseConfig{Enabled: false},
 				ManagedLoadBalancers: ManagedLoadBalancerConfig{
 					Enabled:             true,
 					AutoDiscover:        true,
@@ -242,11 +296,146 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "invalid retry jitter",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API: APIConfig{
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+					},
+					Token: "token",
+					Retry: RetryConfig{MaxAttempts: 3, Jitter: 1.5},
+				},
+				ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid retry max backoff below initial",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API: APIConfig{
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.upcloud.com",
+						Timeout:  10,
+					},
+					Token: "token",
+					Retry: RetryConfig{MaxAttempts: 3, InitialBackoff: 10, MaxBackoff: 5},
+				},
+				ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "storage without backfill",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
+				ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+				StorageID:        func() *component.ID { id := component.MustNewID("file_storage"); return &id }(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid spread schedule",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 60 * time.Second},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10 * time.Second}, Token: "token"},
+				ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+				Schedule:         ScheduleConfig{Mode: "spread", Jitter: 5 * time.Second},
+			},
+			wantErr: false,
+		},
+		{
+			name: "spread schedule without room for the api timeout",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 10 * time.Second},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10 * time.Second}, Token: "token"},
+				ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+				Schedule:         ScheduleConfig{Mode: "spread"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown schedule mode",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
+				ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+				Schedule:         ScheduleConfig{Mode: "staggered"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative schedule jitter",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
+				ManagedDatabases: ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+				Schedule:         ScheduleConfig{Jitter: -time.Second},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative details refresh interval",
+			cfg: Config{
+				ControllerConfig:       scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:                    APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
+				ManagedDatabases:       ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+				DetailsRefreshInterval: -time.Minute,
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid selector",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
+				ManagedDatabases: ManagedDatabaseConfig{
+					Enabled:        true,
+					AutoDiscover:   true,
+					DiscoveryPath:  defaultManagedDatabaseDiscovery,
+					DiscoveryLimit: defaultDiscoveryLimit,
+					Selector:       SelectorConfig{Labels: map[string]string{"env": "staging"}, Zones: []string{"fi-hel2"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "selector without auto discover",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
+				ManagedDatabases: ManagedDatabaseConfig{
+					Enabled:  true,
+					UUIDs:    []string{"db-uuid"},
+					Selector: SelectorConfig{States: []string{"running"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid selector regex",
+			cfg: Config{
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
+				ManagedLoadBalancers: ManagedLoadBalancerConfig{
+					Enabled:             true,
+					AutoDiscover:        true,
+					DiscoveryPath:       defaultManagedLoadBalancerDiscovery,
+					MetricsPathTemplate: defaultLoadBalancerMetricsTemplate,
+					Selector:            SelectorConfig{LabelRegex: map[string]string{"env": "("}},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "no resources enabled",
 			cfg: Config{
-				CollectionInterval: 30,
-				API:                APIConfig{Endpoint: "https://api.upcloud.com", Token: "token", Timeout: 10},
+				ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 30},
+				API:              APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
 			},
 			wantErr: true,
 		},
@@ -264,3 +453,49 @@ func TestConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestLabelsConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     LabelsConfig
+		wantErr bool
+	}{
+		{name: "disabled without prefix", cfg: LabelsConfig{}},
+		{name: "enabled", cfg: LabelsConfig{Enabled: true, Include: []string{"team", "cost-*"}, Prefix: defaultLabelPrefix}},
+		{name: "empty prefix", cfg: LabelsConfig{Enabled: true, Prefix: " "}, wantErr: true},
+		{name: "invalid pattern", cfg: LabelsConfig{Enabled: true, Exclude: []string{"[team"}, Prefix: defaultLabelPrefix}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigUnmarshal_ResourceAttributeLabels(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	conf := confmap.NewFromStringMap(map[string]any{
+		"resource_attributes": map[string]any{
+			"cloud.provider": map[string]any{"enabled": false},
+			"labels": map[string]any{
+				"enabled": true,
+				"include": []any{"team", "env"},
+				"exclude": []any{"env"},
+			},
+		},
+	})
+	if err := cfg.Unmarshal(conf); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	want := LabelsConfig{Enabled: true, Include: []string{"team", "env"}, Exclude: []string{"env"}, Prefix: defaultLabelPrefix}
+	if !reflect.DeepEqual(cfg.Labels, want) {
+		t.Fatalf("unexpected labels config:\n got %+v\nwant %+v", cfg.Labels, want)
+	}
+	if cfg.MetricsBuilderConfig.ResourceAttributes.CloudProvider.Enabled {
+		t.Fatalf("expected the generated resource attribute toggles to be decoded")
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// counterTracker remembers the last point of every cumulative series, so the
+// start timestamp of a counter stays the same between scrapes and a counter
+// that went down is reported as reset. Like checkpoints, the points of a
+// scrape only become the reference for the next one once its batch is
+// committed.
+type counterTracker struct {
+	mu        sync.Mutex
+	committed map[string]counterPoint
+	pending   map[string]counterPoint
+	forgotten map[string]struct{}
+}
+
+type counterPoint struct {
+	resource  string
+	start     pcommon.Timestamp
+	timestamp pcommon.Timestamp
+	value     float64
+}
+
+func newCounterTracker() *counterTracker {
+	return &counterTracker{
+		committed: make(map[string]counterPoint),
+		pending:   make(map[string]counterPoint),
+		forgotten: make(map[string]struct{}),
+	}
+}
+
+// adjust sets the start timestamp of every monotonic cumulative data point of
+// rm. The first point of a series starts at its own timestamp. A point lower
+// than the previous one of its series starts a new run at the timestamp of
+// that previous point. With rates set, a <name>.rate gauge with the per-second
+// increase since the previous point is appended for every counter; points
+// right after a reset have no rate.
+func (t *counterTracker) adjust(resourceType string, uuid string, rm pmetric.ResourceMetrics, rates bool) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	resource := resourceKey(resourceType, uuid)
+	sms := rm.ScopeMetrics()
+	for i := 0; i < sms.Len(); i++ {
+		ms := sms.At(i).Metrics()
+		// Rate gauges are appended after the loop so they are not visited.
+		rateMetrics := pmetric.NewMetricSlice()
+		for j := 0; j < ms.Len(); j++ {
+			m := ms.At(j)
+			if m.Type() != pmetric.MetricTypeSum || !m.Sum().IsMonotonic() ||
+				m.Sum().AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+				continue
+			}
+
+			var rate pmetric.Metric
+			if rates {
+				rate = rateMetrics.AppendEmpty()
+				rate.SetName(m.Name() + ".rate")
+				rate.SetUnit(m.Unit() + "/s")
+				rate.SetDescription("Per-second increase of " + m.Name() + ".")
+				rate.SetEmptyGauge()
+			}
+
+			dps := m.Sum().DataPoints()
+			for k := 0; k < dps.Len(); k++ {
+				dp := dps.At(k)
+				key := resource + "\x00" + m.Name() + "\x00" + attributesKey(dp.Attributes())
+				point := counterPoint{resource: resource, start: dp.Timestamp(), timestamp: dp.Timestamp(), value: dp.DoubleValue()}
+
+				previous, ok := t.pending[key]
+				if !ok {
+					previous, ok = t.committed[key]
+				}
+				if ok && dp.Timestamp() < previous.timestamp {
+					// An out of order point does not move the series.
+					dp.SetStartTimestamp(min(previous.start, dp.Timestamp()))
+					continue
+				}
+				if ok {
+					point.start = previous.start
+					if dp.DoubleValue() < previous.value {
+						point.start = previous.timestamp
+					} else if rates && dp.Timestamp() > previous.timestamp {
+						seconds := float64(dp.Timestamp()-previous.timestamp) / 1e9
+						rdp := rate.Gauge().DataPoints().AppendEmpty()
+						dp.Attributes().CopyTo(rdp.Attributes())
+						rdp.SetTimestamp(dp.Timestamp())
+						rdp.SetDoubleValue((dp.DoubleValue() - previous.value) / seconds)
+					}
+				}
+				dp.SetStartTimestamp(point.start)
+				t.pending[key] = point
+			}
+		}
+		rateMetrics.RemoveIf(func(m pmetric.Metric) bool { return m.Gauge().DataPoints().Len() == 0 })
+		rateMetrics.MoveAndAppendTo(ms)
+	}
+}
+
+// commit makes the points of the consumed batch the reference for the next
+// scrape and drops the series of forgotten resources.
+func (t *counterTracker) commit() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, point := range t.pending {
+		t.committed[key] = point
+	}
+	if len(t.forgotten) > 0 {
+		for key, point := range t.committed {
+			if _, ok := t.forgotten[point.resource]; ok {
+				delete(t.committed, key)
+			}
+		}
+	}
+	clear(t.pending)
+	clear(t.forgotten)
+}
+
+// discard drops the points of a batch that was not consumed.
+func (t *counterTracker) discard() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	clear(t.pending)
+	clear(t.forgotten)
+}
+
+// forget drops the series of a resource that is no longer a target once the
+// current batch is committed.
+func (t *counterTracker) forget(resourceType string, uuid string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.forgotten[resourceKey(resourceType, uuid)] = struct{}{}
+}
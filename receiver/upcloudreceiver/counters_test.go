@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+var counterBase = time.Date(2026, 2, 21, 8, 0, 0, 0, time.UTC)
+
+// counterResource returns a resource with one point of a cumulative counter,
+// taken the given number of minutes after counterBase.
+func counterResource(minutes int, value float64) pmetric.ResourceMetrics {
+	rm := pmetric.NewResourceMetrics()
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("upcloud.managed_load_balancer.frontend.http.requests")
+	m.SetUnit("{request}")
+	sum := m.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := sum.DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(counterBase.Add(time.Duration(minutes) * time.Minute)))
+	dp.SetDoubleValue(value)
+	dp.Attributes().PutStr("upcloud.series", "frontend:web")
+	return rm
+}
+
+func adjustAndCommit(tracker *counterTracker, rm pmetric.ResourceMetrics, rates bool) pmetric.NumberDataPoint {
+	tracker.adjust(resourceTypeManagedLoadBalancer, "lb-1", rm, rates)
+	tracker.commit()
+	return rm.ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+}
+
+func TestCounterTracker_StartTimestamps(t *testing.T) {
+	tracker := newCounterTracker()
+	start := pcommon.NewTimestampFromTime(counterBase)
+
+	if dp := adjustAndCommit(tracker, counterResource(0, 100), false); dp.StartTimestamp() != start {
+		t.Fatalf("expected the first point to start at its own timestamp, got %s", dp.StartTimestamp())
+	}
+	if dp := adjustAndCommit(tracker, counterResource(1, 150), false); dp.StartTimestamp() != start {
+		t.Fatalf("expected the start timestamp to be kept, got %s", dp.StartTimestamp())
+	}
+	// The same row is returned again when the API has nothing newer.
+	if dp := adjustAndCommit(tracker, counterResource(1, 150), false); dp.StartTimestamp() != start {
+		t.Fatalf("expected a repeated point to keep the start timestamp, got %s", dp.StartTimestamp())
+	}
+
+	// The counter went down: a new run starts at the previous point.
+	reset := pcommon.NewTimestampFromTime(counterBase.Add(time.Minute))
+	if dp := adjustAndCommit(tracker, counterResource(2, 20), false); dp.StartTimestamp() != reset {
+		t.Fatalf("expected a reset to start at %s, got %s", reset, dp.StartTimestamp())
+	}
+	if dp := adjustAndCommit(tracker, counterResource(3, 40), false); dp.StartTimestamp() != reset {
+		t.Fatalf("expected the new run to keep its start timestamp, got %s", dp.StartTimestamp())
+	}
+}
+
+func TestCounterTracker_DiscardDoesNotAdvance(t *testing.T) {
+	tracker := newCounterTracker()
+	adjustAndCommit(tracker, counterResource(0, 100), false)
+
+	tracker.adjust(resourceTypeManagedLoadBalancer, "lb-1", counterResource(1, 10), false)
+	tracker.discard()
+
+	// Compared to the committed point, not the discarded one, so no reset.
+	if dp := adjustAndCommit(tracker, counterResource(2, 120), false); dp.StartTimestamp() != pcommon.NewTimestampFromTime(counterBase) {
+		t.Fatalf("expected the discarded point to be ignored, got start %s", dp.StartTimestamp())
+	}
+}
+
+func TestCounterTracker_ForgetDropsSeriesOnCommit(t *testing.T) {
+	tracker := newCounterTracker()
+	adjustAndCommit(tracker, counterResource(0, 100), false)
+
+	tracker.forget(resourceTypeManagedLoadBalancer, "lb-1")
+	tracker.commit()
+
+	want := pcommon.NewTimestampFromTime(counterBase.Add(5 * time.Minute))
+	if dp := adjustAndCommit(tracker, counterResource(5, 10), false); dp.StartTimestamp() != want {
+		t.Fatalf("expected a forgotten series to start over, got %s", dp.StartTimestamp())
+	}
+}
+
+func TestCounterTracker_Rates(t *testing.T) {
+	tracker := newCounterTracker()
+
+	rm := counterResource(0, 100)
+	adjustAndCommit(tracker, rm, true)
+	if got := rm.ScopeMetrics().At(0).Metrics().Len(); got != 1 {
+		t.Fatalf("expected no rate for the first point, got %d metrics", got)
+	}
+
+	rm = counterResource(1, 160)
+	adjustAndCommit(tracker, rm, true)
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	if ms.Len() != 2 {
+		t.Fatalf("expected a rate gauge, got %d metrics", ms.Len())
+	}
+	rate := ms.At(1)
+	if rate.Name() != "upcloud.managed_load_balancer.frontend.http.requests.rate" || rate.Unit() != "{request}/s" {
+		t.Fatalf("unexpected rate metric %q (%s)", rate.Name(), rate.Unit())
+	}
+	dp := rate.Gauge().DataPoints().At(0)
+	if dp.DoubleValue() != 1 {
+		t.Fatalf("expected 60 requests per minute to be 1/s, got %v", dp.DoubleValue())
+	}
+	if series, _ := dp.Attributes().Get("upcloud.series"); series.Str() != "frontend:web" {
+		t.Fatalf("expected the rate to keep the counter attributes, got %q", series.Str())
+	}
+
+	rm = counterResource(2, 5)
+	adjustAndCommit(tracker, rm, true)
+	if got := rm.ScopeMetrics().At(0).Metrics().Len(); got != 1 {
+		t.Fatalf("expected no rate right after a reset, got %d metrics", got)
+	}
+}
+
+func TestDescriptorForMetric_TotalsAreSums(t *testing.T) {
+	d := descriptorForMetric(resourceTypeManagedLoadBalancer, "", "frontend.total_denied_requests", MetricsHints{}, nil)
+	if d.Instrument != instrumentSum {
+		t.Fatalf("expected a snapshot total to be a sum, got %q", d.Instrument)
+	}
+	d = descriptorForMetric(resourceTypeManagedLoadBalancer, "", "frontend.request_rate", MetricsHints{}, nil)
+	if d.Instrument == instrumentSum {
+		t.Fatalf("expected a rate to stay a gauge")
+	}
+}
+
+func TestScrapeMetrics_LoadBalancerCounters(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		CounterRates:         true,
+		ManagedLoadBalancers: ManagedLoadBalancerConfig{Enabled: true, UUIDs: []string{"lb-1"}},
+	}
+	snapshot := func(at string, requests float64, bytes float64) MetricsResponse {
+		return MetricsResponse{
+			"frontend.total_http_requests": {
+				Data: MetricsData{
+					Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "frontend:web", Type: "number"}},
+					Rows: [][]any{{at, requests}},
+				},
+			},
+			"backend.total_request_bytes": {
+				Data: MetricsData{
+					Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "backend:api", Type: "number"}},
+					Rows: [][]any{{at, bytes}},
+				},
+			},
+		}
+	}
+	client := &fakeClient{lbResp: snapshot("2026-02-21T08:00:00Z", 100, 2048)}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+
+	if _, err := scrapeAndCommit(client, cfg, state); err != nil {
+		t.Fatalf("first scrape: %v", err)
+	}
+	client.lbResp = snapshot("2026-02-21T08:00:10Z", 150, 4096)
+	metrics, err := scrapeAndCommit(client, cfg, state)
+	if err != nil {
+		t.Fatalf("second scrape: %v", err)
+	}
+
+	start := pcommon.NewTimestampFromTime(counterBase)
+	found := map[string]pmetric.Metric{}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		found[ms.At(i).Name()] = ms.At(i)
+	}
+	for name, unit := range map[string]string{
+		"upcloud.managed_load_balancer.frontend.http.requests": "{request}",
+		"upcloud.managed_load_balancer.backend.request.bytes":  "By",
+	} {
+		m, ok := found[name]
+		if !ok {
+			t.Fatalf("expected metric %s, got %v", name, found)
+		}
+		if m.Type() != pmetric.MetricTypeSum || !m.Sum().IsMonotonic() || m.Sum().AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+			t.Fatalf("expected %s to be a monotonic cumulative sum", name)
+		}
+		if m.Unit() != unit {
+			t.Fatalf("expected %s in %s, got %s", name, unit, m.Unit())
+		}
+		if got := m.Sum().DataPoints().At(0).StartTimestamp(); got != start {
+			t.Fatalf("expected %s to start at the first scrape, got %s", name, got)
+		}
+	}
+	rate, ok := found["upcloud.managed_load_balancer.backend.request.bytes.rate"]
+	if !ok {
+		t.Fatalf("expected a rate gauge for the backend bytes, got %v", found)
+	}
+	if got := rate.Gauge().DataPoints().At(0).DoubleValue(); got != 204.8 {
+		t.Fatalf("expected 2048 bytes in 10s to be 204.8 By/s, got %v", got)
+	}
+}
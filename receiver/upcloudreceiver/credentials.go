@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// credentialStore holds the request credentials and re-reads token_file and
+// password_file when asked to, so rotated secrets are picked up without a
+// restart. Requests load the current credentials atomically; reloads are
+// serialized, and a 401 for credentials that were already replaced by a
+// concurrent reload is retried without reading the files again.
+type credentialStore struct {
+	api             APIConfig
+	refreshInterval time.Duration
+	logger          *zap.Logger
+	now             func() time.Time
+
+	current atomic.Pointer[requestAuth]
+
+	mu         sync.Mutex
+	lastReload time.Time
+}
+
+func newCredentialStore(api APIConfig, logger *zap.Logger) (*credentialStore, error) {
+	auth, err := resolveRequestAuth(api)
+	if err != nil {
+		return nil, err
+	}
+	s := &credentialStore{
+		api:             api,
+		refreshInterval: api.CredentialsRefreshInterval,
+		logger:          logger,
+		now:             time.Now,
+	}
+	s.current.Store(&auth)
+	s.lastReload = s.now()
+	return s, nil
+}
+
+// load returns the credentials for the next request, re-reading the secret
+// files first when the refresh interval has elapsed.
+func (s *credentialStore) load() requestAuth {
+	if s.reloadable() && s.refreshInterval > 0 {
+		s.mu.Lock()
+		if s.now().Sub(s.lastReload) >= s.refreshInterval {
+			s.reloadLocked("refresh_interval")
+		}
+		s.mu.Unlock()
+	}
+	return *s.current.Load()
+}
+
+// renew is called when the credentials sent with a request were rejected. It
+// reports whether a retry would use different credentials, either because a
+// concurrent request already swapped them or because re-reading the secret
+// files changed them.
+func (s *credentialStore) renew(rejected requestAuth, reason string) bool {
+	if !s.reloadable() {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if *s.current.Load() != rejected {
+		return true
+	}
+	return s.reloadLocked(reason)
+}
+
+// reloadLocked re-reads the secret files and swaps in the new credentials. It
+// reports whether the credentials changed. Read errors keep the previous
+// credentials in place. s.mu must be held.
+func (s *credentialStore) reloadLocked(reason string) bool {
+	s.lastReload = s.now()
+
+	auth, err := resolveRequestAuth(s.api)
+	if err != nil {
+		s.logger.Warn("Failed to reload UpCloud API credentials, keeping previous credentials",
+			zap.String("reason", reason),
+			zap.Error(err),
+		)
+		return false
+	}
+	if auth == *s.current.Load() {
+		return false
+	}
+	s.current.Store(&auth)
+	s.logger.Info("Reloaded UpCloud API credentials", zap.String("reason", reason))
+	return true
+}
+
+func (s *credentialStore) reloadable() bool {
+	return strings.TrimSpace(s.api.TokenFile) != "" || strings.TrimSpace(s.api.PasswordFile) != ""
+}
@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.uber.org/zap"
+)
+
+func TestHTTPClient_ReloadsTokenFileOnUnauthorized(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("old-token\n"), 0o600); err != nil {
+		t.Fatalf("write token file: %v", err)
+	}
+
+	dbFixture := mustReadFixture(t, "testdata/integration/managed_database_metrics.json")
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Header.Get("Authorization") != "Bearer new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"error_code":"AUTHENTICATION_FAILED","error_message":"Authentication failed."}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(dbFixture)
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+		},
+		TokenFile: tokenFile,
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		t.Fatalf("new http client: %v", err)
+	}
+
+	if err := os.WriteFile(tokenFile, []byte("new-token\n"), 0o600); err != nil {
+		t.Fatalf("rotate token file: %v", err)
+	}
+
+	if _, err := client.GetManagedDatabaseMetrics(context.Background(), "db-uuid", "hour"); err != nil {
+		t.Fatalf("expected request to succeed with rotated token, got %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 calls (401 then success), got %d", got)
+	}
+}
+
+func TestCredentialStore_RefreshInterval(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("first"), 0o600); err != nil {
+		t.Fatalf("write token file: %v", err)
+	}
+
+	store, err := newCredentialStore(APIConfig{
+		TokenFile:                  tokenFile,
+		CredentialsRefreshInterval: time.Minute,
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("new credential store: %v", err)
+	}
+	current := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return current }
+	store.lastReload = current
+
+	if err := os.WriteFile(tokenFile, []byte("second"), 0o600); err != nil {
+		t.Fatalf("rotate token file: %v", err)
+	}
+	if got := store.load().bearerToken; got != "first" {
+		t.Fatalf("expected cached token before refresh interval, got %q", got)
+	}
+
+	current = current.Add(time.Minute)
+	if got := store.load().bearerToken; got != "second" {
+		t.Fatalf("expected reloaded token after refresh interval, got %q", got)
+	}
+}
+
+func TestCredentialStore_KeepsPreviousCredentialsOnReadError(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("first"), 0o600); err != nil {
+		t.Fatalf("write token file: %v", err)
+	}
+
+	store, err := newCredentialStore(APIConfig{TokenFile: tokenFile}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("new credential store: %v", err)
+	}
+	if err := os.WriteFile(tokenFile, nil, 0o600); err != nil {
+		t.Fatalf("truncate token file: %v", err)
+	}
+
+	if store.renew(store.load(), "test") {
+		t.Fatalf("expected reload of empty token file to be rejected")
+	}
+	if got := store.load().bearerToken; got != "first" {
+		t.Fatalf("expected previous token to be kept, got %q", got)
+	}
+}
+
+func TestHTTPClient_ConcurrentUnauthorizedRetriesWithRotatedToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("old-token\n"), 0o600); err != nil {
+		t.Fatalf("write token file: %v", err)
+	}
+
+	const requests = 4
+	dbFixture := mustReadFixture(t, "testdata/integration/managed_database_metrics.json")
+	var rejected atomic.Int32
+	allRejected := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer new-token" {
+			// Hold every old-token request until all of them are in flight,
+			// so they all see the 401 before any of them reloads.
+			if rejected.Add(1) == requests {
+				close(allRejected)
+			}
+			select {
+			case <-allRejected:
+			case <-time.After(2 * time.Second):
+			}
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"error_code":"AUTHENTICATION_FAILED","error_message":"Authentication failed."}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(dbFixture)
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  5 * time.Second,
+		},
+		TokenFile: tokenFile,
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		t.Fatalf("new http client: %v", err)
+	}
+	if err := os.WriteFile(tokenFile, []byte("new-token\n"), 0o600); err != nil {
+		t.Fatalf("rotate token file: %v", err)
+	}
+
+	errs := make(chan error, requests)
+	for i := 0; i < requests; i++ {
+		go func() {
+			_, err := client.GetManagedDatabaseMetrics(context.Background(), "db-uuid", "hour")
+			errs <- err
+		}()
+	}
+	for i := 0; i < requests; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("expected every concurrent request to succeed after the rotation, got %v", err)
+		}
+	}
+	if got := rejected.Load(); got != requests {
+		t.Fatalf("expected %d rejected requests, got %d", requests, got)
+	}
+}
+
+func TestCredentialStore_RenewAfterConcurrentReload(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("old-token\n"), 0o600); err != nil {
+		t.Fatalf("write token file: %v", err)
+	}
+	store, err := newCredentialStore(APIConfig{TokenFile: tokenFile}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("new credential store: %v", err)
+	}
+	rejected := store.load()
+
+	if err := os.WriteFile(tokenFile, []byte("new-token\n"), 0o600); err != nil {
+		t.Fatalf("rotate token file: %v", err)
+	}
+	if !store.renew(rejected, "unauthorized") {
+		t.Fatalf("expected the first rejected request to reload the token")
+	}
+
+	// The second request was rejected with the same old token. The file is now
+	// unreadable, so only the already swapped credentials can justify a retry.
+	if err := os.Remove(tokenFile); err != nil {
+		t.Fatalf("remove token file: %v", err)
+	}
+	if !store.renew(rejected, "unauthorized") {
+		t.Fatalf("expected a retry with the credentials reloaded by the concurrent request")
+	}
+	if store.renew(store.load(), "unauthorized") {
+		t.Fatalf("expected no retry when the current credentials were rejected and cannot be reloaded")
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"sync"
+	"time"
+)
+
+// discoveryCache keeps the discovered resources of each resource type between
+// scrapes. The list endpoints are called again once discovery_interval has
+// passed or the cache was invalidated; when that call fails, the last good
+// set is used instead. Explicitly configured UUIDs that discovery does not
+// return are looked up one by one and cached for details_refresh_interval.
+type discoveryCache struct {
+	interval        time.Duration
+	detailsInterval time.Duration
+	now             func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*discoveryEntry
+	details map[string]*detailsEntry
+	// forgotten holds the resources whose details are dropped on commit.
+	forgotten map[string]struct{}
+}
+
+type discoveryEntry struct {
+	resources []ResourceInfo
+	byUUID    map[string]ResourceInfo
+	refreshed time.Time
+	expired   bool
+}
+
+type detailsEntry struct {
+	info      ResourceInfo
+	refreshed time.Time
+}
+
+func newDiscoveryCache(interval time.Duration, detailsInterval time.Duration) *discoveryCache {
+	return &discoveryCache{
+		interval:        interval,
+		detailsInterval: detailsInterval,
+		now:             time.Now,
+		entries:         make(map[string]*discoveryEntry),
+		details:         make(map[string]*detailsEntry),
+		forgotten:       make(map[string]struct{}),
+	}
+}
+
+// lookup returns the cached resources of resourceType, calling discover when
+// the cache is empty, expired or older than the discovery interval. A nil
+// cache always calls discover.
+func (c *discoveryCache) lookup(resourceType string, discover func() ([]ResourceInfo, error)) ([]ResourceInfo, error) {
+	if c == nil {
+		return discover()
+	}
+
+	c.mu.Lock()
+	entry := c.entries[resourceType]
+	fresh := entry != nil && !entry.expired && c.fresh(entry.refreshed, c.interval)
+	c.mu.Unlock()
+	if fresh {
+		return entry.resources, nil
+	}
+
+	resources, err := discover()
+	if err != nil {
+		if entry != nil {
+			return entry.resources, err
+		}
+		return nil, err
+	}
+
+	byUUID := make(map[string]ResourceInfo, len(resources))
+	for _, info := range resources {
+		byUUID[info.UUID] = info
+	}
+	c.mu.Lock()
+	c.entries[resourceType] = &discoveryEntry{resources: resources, byUUID: byUUID, refreshed: c.now()}
+	c.mu.Unlock()
+	return resources, nil
+}
+
+// info returns what is known about a resource, from discovery or an earlier
+// details lookup. Unknown resources only carry their UUID.
+func (c *discoveryCache) info(resourceType string, uuid string) ResourceInfo {
+	if c == nil {
+		return ResourceInfo{UUID: uuid}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry := c.entries[resourceType]; entry != nil {
+		if info, ok := entry.byUUID[uuid]; ok {
+			return info
+		}
+	}
+	if entry := c.details[resourceKey(resourceType, uuid)]; entry != nil {
+		return entry.info
+	}
+	return ResourceInfo{UUID: uuid}
+}
+
+// lookupDetails calls fetch for a resource that neither the last discovery
+// nor a details lookup within the details refresh interval knows about. A nil
+// cache always calls fetch.
+func (c *discoveryCache) lookupDetails(resourceType string, uuid string, fetch func() (ResourceInfo, error)) (ResourceInfo, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	key := resourceKey(resourceType, uuid)
+	c.mu.Lock()
+	if entry := c.entries[resourceType]; entry != nil {
+		if info, ok := entry.byUUID[uuid]; ok {
+			c.mu.Unlock()
+			return info, nil
+		}
+	}
+	entry := c.details[key]
+	c.mu.Unlock()
+	if entry != nil && c.fresh(entry.refreshed, c.detailsInterval) {
+		return entry.info, nil
+	}
+
+	info, err := fetch()
+	if err != nil {
+		return ResourceInfo{UUID: uuid}, err
+	}
+	c.mu.Lock()
+	c.details[key] = &detailsEntry{info: info, refreshed: c.now()}
+	c.mu.Unlock()
+	return info, nil
+}
+
+// fresh reports whether something refreshed at refreshed is still within
+// interval. An interval of 0 is never fresh.
+func (c *discoveryCache) fresh(refreshed time.Time, interval time.Duration) bool {
+	return interval > 0 && c.now().Sub(refreshed) < interval
+}
+
+// discovered reports whether uuid is in the cached discovered set of
+// resourceType, as opposed to a configured UUID.
+func (c *discoveryCache) discovered(resourceType string, uuid string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := c.entries[resourceType]
+	if entry == nil {
+		return false
+	}
+	_, ok := entry.byUUID[uuid]
+	return ok
+}
+
+// invalidate forces the next lookup of resourceType to call the list endpoint.
+func (c *discoveryCache) invalidate(resourceType string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[resourceType]; ok {
+		entry.expired = true
+	}
+}
+
+// forget drops the details of a resource that is no longer scraped once the
+// current batch is committed.
+func (c *discoveryCache) forget(resourceType string, uuid string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.forgotten[resourceKey(resourceType, uuid)] = struct{}{}
+}
+
+// commit drops the details of the resources forgotten by the consumed batch.
+func (c *discoveryCache) commit() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.forgotten {
+		delete(c.details, key)
+	}
+	clear(c.forgotten)
+}
+
+// discard keeps the details of the resources forgotten by a batch that was
+// not consumed.
+func (c *discoveryCache) discard() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.forgotten)
+}
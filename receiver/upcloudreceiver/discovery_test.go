@@ -0,0 +1,283 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+// discoveryClient counts discovery calls and can fail discovery or return
+// 404 for selected UUIDs.
+type discoveryClient struct {
+	fakeClient
+	listCalls    int
+	failList     bool
+	notFoundUUID string
+	// databases, when set, is returned by discovery instead of dbList.
+	databases   []ResourceInfo
+	detailCalls int
+	failDetails bool
+}
+
+func (c *discoveryClient) ListManagedDatabases(context.Context, string, int) ([]ResourceInfo, error) {
+	c.listCalls++
+	if c.failList {
+		return nil, errors.New("connection reset")
+	}
+	if c.databases != nil {
+		return c.databases, nil
+	}
+	return toResourceInfos(c.dbList), nil
+}
+
+func (c *discoveryClient) GetManagedDatabase(_ context.Context, _ string, uuid string) (ResourceInfo, error) {
+	c.detailCalls++
+	if c.failDetails {
+		return ResourceInfo{}, errors.New("connection reset")
+	}
+	return ResourceInfo{UUID: uuid, Name: "explicit", Zone: "de-fra1", Type: "mysql", Version: "8.0", State: "running"}, nil
+}
+
+func (c *discoveryClient) GetManagedDatabaseMetrics(_ context.Context, uuid string, _ string) (MetricsResponse, error) {
+	if uuid == c.notFoundUUID {
+		return nil, &APIError{StatusCode: http.StatusNotFound, Code: "DATABASE_NOT_FOUND", Endpoint: "/1.3/database/" + uuid + "/metrics"}
+	}
+	return c.dbResp, nil
+}
+
+func TestDiscoveryCache_RefreshesOnInterval(t *testing.T) {
+	now := time.Date(2026, 2, 21, 8, 0, 0, 0, time.UTC)
+	cache := newDiscoveryCache(10*time.Minute, 0)
+	cache.now = func() time.Time { return now }
+	calls := 0
+	discover := func() ([]ResourceInfo, error) {
+		calls++
+		return []ResourceInfo{{UUID: "db-1"}}, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := cache.lookup(resourceTypeManagedDatabase, discover); err != nil {
+			t.Fatalf("lookup: %v", err)
+		}
+		now = now.Add(time.Minute)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 discovery call within the interval, got %d", calls)
+	}
+
+	now = now.Add(10 * time.Minute)
+	if _, err := cache.lookup(resourceTypeManagedDatabase, discover); err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected a refresh after the interval, got %d calls", calls)
+	}
+}
+
+func TestDiscoveryCache_KeepsLastGoodSetOnFailure(t *testing.T) {
+	cache := newDiscoveryCache(0, 0)
+	if _, err := cache.lookup(resourceTypeManagedDatabase, func() ([]ResourceInfo, error) {
+		return []ResourceInfo{{UUID: "db-1"}, {UUID: "db-2"}}, nil
+	}); err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+
+	resources, err := cache.lookup(resourceTypeManagedDatabase, func() ([]ResourceInfo, error) {
+		return nil, errors.New("connection reset")
+	})
+	if err == nil {
+		t.Fatalf("expected the refresh error to be reported")
+	}
+	if len(resources) != 2 {
+		t.Fatalf("expected the last good set, got %v", resources)
+	}
+}
+
+func TestScrapeMetrics_DiscoveryCacheRefreshesOnNotFound(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		DiscoveryInterval:    time.Hour,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, AutoDiscover: true},
+	}
+	client := &discoveryClient{fakeClient: fakeClient{
+		dbList: []string{"db-1", "db-2"},
+		dbResp: MetricsResponse{
+			"cpu_usage": {
+				Data: MetricsData{
+					Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}},
+					Rows: [][]any{{"2026-02-21T08:00:00Z", 10.0}},
+				},
+			},
+		},
+	}}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+
+	for i := 0; i < 2; i++ {
+		if _, err := scrapeAndCommit(client, cfg, state); err != nil {
+			t.Fatalf("scrape %d: %v", i, err)
+		}
+	}
+	if client.listCalls != 1 {
+		t.Fatalf("expected cached discovery, got %d list calls", client.listCalls)
+	}
+
+	// db-2 was deleted: the cached UUID returns 404 and forces a refresh.
+	client.notFoundUUID = "db-2"
+	if _, err := scrapeAndCommit(client, cfg, state); err == nil {
+		t.Fatalf("expected a partial error for the deleted database")
+	}
+	client.dbList = []string{"db-1"}
+	metrics, err := scrapeAndCommit(client, cfg, state)
+	if err != nil {
+		t.Fatalf("scrape after refresh: %v", err)
+	}
+	if client.listCalls != 2 {
+		t.Fatalf("expected a forced refresh after 404, got %d list calls", client.listCalls)
+	}
+	if got := countStaleDataPoints(metrics); got != 1 {
+		t.Fatalf("expected a staleness marker for the deleted database, got %d", got)
+	}
+
+	// A failed refresh keeps scraping the last good set.
+	client.failList = true
+	state.discovery.invalidate(resourceTypeManagedDatabase)
+	metrics, err = scrapeAndCommit(client, cfg, state)
+	if err == nil {
+		t.Fatalf("expected the discovery error to be reported")
+	}
+	if metrics.ResourceMetrics().Len() != 1 {
+		t.Fatalf("expected the cached database to be scraped, got %d resources", metrics.ResourceMetrics().Len())
+	}
+}
+
+func TestScrapeMetrics_ConfiguredNotFoundKeepsDiscoveryCache(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		DiscoveryInterval:    time.Hour,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, AutoDiscover: true, UUIDs: []string{"db-9"}},
+	}
+	client := &discoveryClient{
+		fakeClient:   fakeClient{dbList: []string{"db-1"}, dbResp: cpuUsageResponse()},
+		notFoundUUID: "db-9",
+	}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+
+	for i := 0; i < 3; i++ {
+		if _, err := scrapeAndCommit(client, cfg, state); err == nil {
+			t.Fatalf("scrape %d: expected a partial error for the deleted database", i)
+		}
+	}
+	if client.listCalls != 1 {
+		t.Fatalf("expected a 404 for a configured UUID not to force discovery, got %d list calls", client.listCalls)
+	}
+}
+
+func TestScrapeMetrics_EnrichesDiscoveredResources(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		DiscoveryInterval:    time.Hour,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, AutoDiscover: true},
+	}
+	client := &discoveryClient{
+		fakeClient: fakeClient{dbResp: cpuUsageResponse()},
+		databases: []ResourceInfo{{
+			UUID:    "db-1",
+			Name:    "payments",
+			Zone:    "fi-hel2",
+			Plan:    "2x2xCPU-4GB-100GB",
+			Type:    "pg",
+			Version: "16",
+			State:   "running",
+		}},
+	}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+
+	metrics, err := scrapeAndCommit(client, cfg, state)
+	if err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	if client.detailCalls != 0 {
+		t.Fatalf("expected no details lookup for a discovered database, got %d", client.detailCalls)
+	}
+	if metrics.ResourceMetrics().Len() != 1 {
+		t.Fatalf("expected 1 resource, got %d", metrics.ResourceMetrics().Len())
+	}
+	attrs := metrics.ResourceMetrics().At(0).Resource().Attributes()
+	for key, want := range map[string]string{
+		"cloud.provider":           "upcloud",
+		"cloud.region":             "fi-hel",
+		"cloud.availability_zone":  "fi-hel2",
+		"db.system":                "postgresql",
+		"upcloud.database.type":    "pg",
+		"upcloud.database.version": "16",
+		"upcloud.resource.name":    "payments",
+		"upcloud.resource.plan":    "2x2xCPU-4GB-100GB",
+		"upcloud.resource.state":   "running",
+		"upcloud.resource.uuid":    "db-1",
+	} {
+		if got, ok := attrs.Get(key); !ok || got.Str() != want {
+			t.Errorf("expected %s=%q, got %q (present=%t)", key, want, got.Str(), ok)
+		}
+	}
+}
+
+func TestScrapeMetrics_LooksUpExplicitResources(t *testing.T) {
+	now := time.Date(2026, 2, 21, 8, 0, 0, 0, time.UTC)
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		// Details are cached even when discovery runs on every scrape.
+		DiscoveryInterval:      0,
+		DetailsRefreshInterval: 10 * time.Minute,
+		ManagedDatabases:       ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-9"}},
+	}
+	client := &discoveryClient{fakeClient: fakeClient{dbResp: cpuUsageResponse()}}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+	state.discovery.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		metrics, err := scrapeAndCommit(client, cfg, state)
+		if err != nil {
+			t.Fatalf("scrape %d: %v", i, err)
+		}
+		attrs := metrics.ResourceMetrics().At(0).Resource().Attributes()
+		if system, _ := attrs.Get("db.system"); system.Str() != "mysql" {
+			t.Fatalf("expected the looked up db.system, got %q", system.Str())
+		}
+		now = now.Add(time.Minute)
+	}
+	if client.detailCalls != 1 {
+		t.Fatalf("expected details to be cached within the details refresh interval, got %d lookups", client.detailCalls)
+	}
+
+	// A failed lookup keeps the metrics, without enrichment.
+	now = now.Add(time.Hour)
+	client.failDetails = true
+	metrics, err := scrapeAndCommit(client, cfg, state)
+	if err != nil {
+		t.Fatalf("scrape with failing lookup: %v", err)
+	}
+	if metrics.ResourceMetrics().Len() != 1 {
+		t.Fatalf("expected the database to be scraped, got %d resources", metrics.ResourceMetrics().Len())
+	}
+}
+
+func cpuUsageResponse() MetricsResponse {
+	return MetricsResponse{
+		"cpu_usage": {
+			Data: MetricsData{
+				Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}},
+				Rows: [][]any{{"2026-02-21T08:00:00Z", 10.0}},
+			},
+		},
+	}
+}
@@ -1,6 +1,8 @@
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
+//go:generate mdatagen metadata.yaml
+
 // Package upcloudreceiver implements an OpenTelemetry Collector receiver that
 // fetches metrics from the UpCloud API for managed services.
 package upcloudreceiver
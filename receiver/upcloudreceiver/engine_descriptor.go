@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+// engineMetricDescriptors holds the descriptors of engine-specific metric
+// keys, by the UpCloud database type learned at discovery. They take
+// precedence over managedDatabaseMetricDescriptors.
+var engineMetricDescriptors = map[string]map[string]metricDescriptor{
+	"pg":         postgresqlMetricDescriptors,
+	"mysql":      mysqlMetricDescriptors,
+	"opensearch": opensearchMetricDescriptors,
+	"redis":      valkeyMetricDescriptors,
+	"valkey":     valkeyMetricDescriptors,
+}
+
+var postgresqlMetricDescriptors = map[string]metricDescriptor{
+	"connections": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabasePostgresqlConnections.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabasePostgresqlConnectionsDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"replication_lag": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabasePostgresqlReplicationLag.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabasePostgresqlReplicationLagDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"cache_hit_ratio": {
+		Name:           metadata.MetricsInfo.UpcloudManagedDatabasePostgresqlCacheHitRatio.Name,
+		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabasePostgresqlCacheHitRatioDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
+	},
+	"index_scans": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabasePostgresqlIndexScans.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabasePostgresqlIndexScansDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"shared_buffers_usage": {
+		Name:           metadata.MetricsInfo.UpcloudManagedDatabasePostgresqlSharedBuffersUtilization.Name,
+		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabasePostgresqlSharedBuffersUtilizationDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
+	},
+}
+
+var mysqlMetricDescriptors = map[string]metricDescriptor{
+	"connections": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseMysqlConnections.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseMysqlConnectionsDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"replication_lag": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseMysqlReplicationLag.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseMysqlReplicationLagDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"innodb_buffer_pool_hit_ratio": {
+		Name:           metadata.MetricsInfo.UpcloudManagedDatabaseMysqlBufferPoolHitRatio.Name,
+		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseMysqlBufferPoolHitRatioDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
+	},
+	"innodb_buffer_pool_usage": {
+		Name:           metadata.MetricsInfo.UpcloudManagedDatabaseMysqlBufferPoolUtilization.Name,
+		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseMysqlBufferPoolUtilizationDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
+	},
+	"index_reads": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseMysqlIndexReads.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseMysqlIndexReadsDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+}
+
+var opensearchMetricDescriptors = map[string]metricDescriptor{
+	"http_connections": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseOpensearchHTTPConnections.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseOpensearchHTTPConnectionsDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"unassigned_shards": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseOpensearchShardsUnassigned.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseOpensearchShardsUnassignedDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"query_cache_hit_ratio": {
+		Name:           metadata.MetricsInfo.UpcloudManagedDatabaseOpensearchQueryCacheHitRatio.Name,
+		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseOpensearchQueryCacheHitRatioDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
+	},
+	"indexing_rate": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseOpensearchIndexingRate.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseOpensearchIndexingRateDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"jvm_heap_usage": {
+		Name:           metadata.MetricsInfo.UpcloudManagedDatabaseOpensearchJvmHeapUtilization.Name,
+		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseOpensearchJvmHeapUtilizationDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
+	},
+}
+
+var valkeyMetricDescriptors = map[string]metricDescriptor{
+	"connected_clients": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseValkeyClientsConnected.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseValkeyClientsConnectedDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"replication_offset_lag": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseValkeyReplicationOffsetLag.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseValkeyReplicationOffsetLagDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"keyspace_hit_ratio": {
+		Name:           metadata.MetricsInfo.UpcloudManagedDatabaseValkeyKeyspaceHitRatio.Name,
+		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseValkeyKeyspaceHitRatioDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
+	},
+	"used_memory": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseValkeyMemoryUsed.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseValkeyMemoryUsedDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+	"evicted_keys": {
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseValkeyKeysEvicted.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseValkeyKeysEvictedDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
+	},
+}
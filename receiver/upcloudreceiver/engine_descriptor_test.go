@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+// TestScrapeMetrics_EngineGolden scrapes the fixture of each database engine
+// and compares the emitted data points with testdata/engines/<engine>_expected.txt.
+func TestScrapeMetrics_EngineGolden(t *testing.T) {
+	for engine, databaseType := range map[string]string{
+		"postgresql": "pg",
+		"mysql":      "mysql",
+		"opensearch": "opensearch",
+		"valkey":     "valkey",
+	} {
+		t.Run(engine, func(t *testing.T) {
+			var payload MetricsResponse
+			if err := json.Unmarshal(mustReadFixture(t, "testdata/engines/"+engine+"_metrics.json"), &payload); err != nil {
+				t.Fatalf("decode fixture: %v", err)
+			}
+			cfg := &Config{
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, AutoDiscover: true},
+			}
+			client := &discoveryClient{
+				fakeClient: fakeClient{dbResp: payload},
+				databases: []ResourceInfo{{
+					UUID:  "db-1",
+					Type:  databaseType,
+					Nodes: []NodeInfo{{Name: "db-1-1", Role: "master"}, {Name: "db-1-2", Role: "standby"}},
+				}},
+			}
+			state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+
+			metrics, err := scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop())
+			if err != nil {
+				t.Fatalf("scrape: %v", err)
+			}
+
+			got := goldenLines(metrics)
+			want := strings.Split(strings.TrimSpace(string(mustReadFixture(t, "testdata/engines/"+engine+"_expected.txt"))), "\n")
+			if strings.Join(got, "\n") != strings.Join(want, "\n") {
+				t.Fatalf("unexpected data points:\n got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+			}
+		})
+	}
+}
+
+func TestDescriptorForMetric_EngineTable(t *testing.T) {
+	if d := descriptorForMetric(resourceTypeManagedDatabase, "mysql", "connections", MetricsHints{}, nil); d.Name != metadata.MetricsInfo.UpcloudManagedDatabaseMysqlConnections.Name {
+		t.Fatalf("expected the MySQL descriptor, got %s", d.Name)
+	}
+	if d := descriptorForMetric(resourceTypeManagedDatabase, "redis", "used_memory", MetricsHints{}, nil); d.Name != metadata.MetricsInfo.UpcloudManagedDatabaseValkeyMemoryUsed.Name {
+		t.Fatalf("expected redis to use the Valkey descriptors, got %s", d.Name)
+	}
+	// Without a known engine, engine keys use the generic fallback.
+	if d := descriptorForMetric(resourceTypeManagedDatabase, "", "connections", MetricsHints{}, nil); d.record != nil || d.Name != "upcloud.managed_database.connections" {
+		t.Fatalf("expected the generic fallback, got %+v", d)
+	}
+	// Engine tables only apply to databases.
+	if d := descriptorForMetric(resourceTypeManagedLoadBalancer, "pg", "connections", MetricsHints{}, nil); d.record != nil {
+		t.Fatalf("expected no engine descriptor for a load balancer, got %s", d.Name)
+	}
+}
+
+func TestValidateMetricOverrides_EngineNamesReserved(t *testing.T) {
+	err := validateMetricOverrides(resourceTypeManagedDatabase, map[string]MetricOverrideConfig{
+		"threads": {Name: metadata.MetricsInfo.UpcloudManagedDatabasePostgresqlConnections.Name},
+	})
+	if err == nil {
+		t.Fatalf("expected the name of an engine metric to be rejected")
+	}
+}
+
+// goldenLines renders every data point as
+// "<name> <unit> <node role>/<node name>=<value>", sorted.
+func goldenLines(metrics pmetric.Metrics) []string {
+	var lines []string
+	for _, m := range metricsByName(metrics) {
+		dps, ok := numberDataPoints(m)
+		if !ok {
+			continue
+		}
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			role, _ := dp.Attributes().Get("upcloud.node.role")
+			name, _ := dp.Attributes().Get("upcloud.node.name")
+			lines = append(lines, fmt.Sprintf("%s %s %s/%s=%s", m.Name(), m.Unit(), role.Str(), name.Str(), strconv.FormatFloat(dp.DoubleValue(), 'f', -1, 64)))
+		}
+	}
+	sort.Strings(lines)
+	return lines
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
 
 	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
 )
@@ -23,12 +25,30 @@ func NewFactory() receiver.Factory {
 }
 
 func createDefaultConfig() component.Config {
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = defaultAPIEndpoint
+	clientConfig.Timeout = defaultAPITimeout
+
+	controllerConfig := scraperhelper.NewDefaultControllerConfig()
+	controllerConfig.CollectionInterval = defaultCollectionInterval
+	controllerConfig.InitialDelay = defaultInitialDelay
+
 	return &Config{
-		CollectionInterval: defaultCollectionInterval,
-		InitialDelay:       defaultInitialDelay,
+		ControllerConfig:       controllerConfig,
+		MetricsBuilderConfig:   metadata.DefaultMetricsBuilderConfig(),
+		MaxConcurrency:         defaultMaxConcurrency,
+		DetailsRefreshInterval: defaultDetailsRefreshInterval,
+		Schedule:               ScheduleConfig{Mode: scheduleModeBurst},
+		Labels:                 LabelsConfig{Prefix: defaultLabelPrefix},
 		API: APIConfig{
-			Endpoint: defaultAPIEndpoint,
-			Timeout:  defaultAPITimeout,
+			ClientConfig:               clientConfig,
+			CredentialsRefreshInterval: defaultCredentialsRefresh,
+			Retry: RetryConfig{
+				MaxAttempts:    defaultRetryMaxAttempts,
+				InitialBackoff: defaultRetryInitialBackoff,
+				MaxBackoff:     defaultRetryMaxBackoff,
+				Jitter:         defaultRetryJitter,
+			},
 		},
 		ManagedDatabases: ManagedDatabaseConfig{
 			Enabled:        true,
@@ -57,9 +77,6 @@ func createMetricsReceiver(
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
-	client, err := NewHTTPClient(cfg.API, cfg.ManagedLoadBalancers.MetricsPathTemplate)
-	if err != nil {
-		return nil, err
-	}
-	return newMetricsReceiver(cfg, settings, next, client), nil
+	// The HTTP client is built in Start, once host extensions are available.
+	return newMetricsReceiver(cfg, settings, next, nil)
 }
@@ -3,13 +3,25 @@
 
 package upcloudreceiver
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
 
 func TestCreateDefaultConfig(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	if cfg.API.Endpoint == "" {
 		t.Fatalf("default api endpoint must be set")
 	}
+	if cfg.API.Timeout != defaultAPITimeout {
+		t.Fatalf("default api timeout must be %s, got %s", defaultAPITimeout, cfg.API.Timeout)
+	}
 	if cfg.CollectionInterval <= 0 {
 		t.Fatalf("default collection interval must be > 0")
 	}
@@ -19,4 +31,20 @@ func TestCreateDefaultConfig(t *testing.T) {
 	if !cfg.ManagedDatabases.AutoDiscover {
 		t.Fatalf("managed_databases auto_discover should be enabled by default")
 	}
+	if cfg.DetailsRefreshInterval != defaultDetailsRefreshInterval {
+		t.Fatalf("default details refresh interval must be %s, got %s", defaultDetailsRefreshInterval, cfg.DetailsRefreshInterval)
+	}
+}
+
+func TestCreateMetricsReceiver_StartFailsOnInvalidCredentialFile(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.API.TokenFile = "/non-existent/token"
+
+	r, err := NewFactory().CreateMetrics(context.Background(), receivertest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	if err != nil {
+		t.Fatalf("create metrics receiver: %v", err)
+	}
+	if err := r.Start(context.Background(), componenttest.NewNopHost()); err == nil {
+		t.Fatalf("expected start to fail for missing credential file")
+	}
 }
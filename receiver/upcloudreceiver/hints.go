@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"regexp"
+	"strings"
+)
+
+// inferredUnit is the unit of a metric key without a descriptor, as far as the
+// hints of the API and the key itself tell.
+type inferredUnit struct {
+	Unit           string
+	PercentToRatio bool
+}
+
+var (
+	unitPercent    = inferredUnit{Unit: "1", PercentToRatio: true}
+	unitBytes      = inferredUnit{Unit: "By"}
+	unitByteRate   = inferredUnit{Unit: "By/s"}
+	unitOperations = inferredUnit{Unit: "{operation}/s"}
+)
+
+// unitWords maps the words of hints and keys that name a unit.
+var unitWords = map[string]inferredUnit{
+	"%":          unitPercent,
+	"percent":    unitPercent,
+	"percentage": unitPercent,
+	"byte":       unitBytes,
+	"bytes":      unitBytes,
+	"byte/s":     unitByteRate,
+	"bytes/s":    unitByteRate,
+	"iops":       unitOperations,
+}
+
+var unitWordPattern = regexp.MustCompile(`%|[a-z]+(?:/s)?`)
+
+// inferUnit returns the unit of metricKey from the first of the unit hint,
+// the format hint, the title and the key that names one:
+//
+//   - "%" or "percent" is a percentage, normalized to a ratio;
+//   - "bytes" is By, or By/s when written "bytes/s";
+//   - "iops" is {operation}/s.
+//
+// Without such hints, a key named *_usage is a percentage.
+func inferUnit(metricKey string, hints MetricsHints) (inferredUnit, bool) {
+	for _, hint := range []string{hints.Unit, hints.Format, hints.Title} {
+		if unit, ok := unitFromText(hint); ok {
+			return unit, true
+		}
+	}
+	if strings.HasSuffix(metricKey, "_usage") {
+		return unitPercent, true
+	}
+	return unitFromText(metricKey)
+}
+
+// unitFromText returns the unit of the first unit word of text.
+func unitFromText(text string) (inferredUnit, bool) {
+	for _, word := range unitWordPattern.FindAllString(strings.ToLower(text), -1) {
+		if unit, ok := unitWords[word]; ok {
+			return unit, true
+		}
+	}
+	return inferredUnit{}, false
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+func TestInferUnit(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		hints MetricsHints
+		want  inferredUnit
+		found bool
+	}{
+		{name: "percent sign in title", key: "steal_time", hints: MetricsHints{Title: "CPU steal %"}, want: unitPercent, found: true},
+		{name: "percent word in title", key: "steal_time", hints: MetricsHints{Title: "Steal time percent"}, want: unitPercent, found: true},
+		{name: "bytes in title", key: "wal_size", hints: MetricsHints{Title: "WAL size (bytes)"}, want: unitBytes, found: true},
+		{name: "bytes per second in title", key: "net_io", hints: MetricsHints{Title: "Network Bytes/s"}, want: unitByteRate, found: true},
+		{name: "iops in title", key: "diskio_merged", hints: MetricsHints{Title: "Disk iops (reads)"}, want: unitOperations, found: true},
+		{name: "unit hint", key: "wal_size", hints: MetricsHints{Unit: "bytes"}, want: unitBytes, found: true},
+		{name: "format hint", key: "steal_time", hints: MetricsHints{Format: "percentage"}, want: unitPercent, found: true},
+		{name: "unit hint before title", key: "steal_time", hints: MetricsHints{Unit: "%", Title: "Steal bytes"}, want: unitPercent, found: true},
+		{name: "format hint before title", key: "wal_size", hints: MetricsHints{Format: "bytes", Title: "WAL usage %"}, want: unitBytes, found: true},
+		{name: "usage key", key: "swap_usage", want: unitPercent, found: true},
+		{name: "hints before usage key", key: "swap_usage", hints: MetricsHints{Title: "Swap usage bytes"}, want: unitBytes, found: true},
+		{name: "bytes in key", key: "backend.member.total_response_bytes", want: unitBytes, found: true},
+		{name: "iops in key", key: "disk_iops_writes", want: unitOperations, found: true},
+		{name: "no unit word", key: "connections", hints: MetricsHints{Title: "Connections"}, found: false},
+		// Words only count as a whole, so "bytes" inside another word does not.
+		{name: "unit inside a word", key: "megabytes_free", hints: MetricsHints{Title: "Megabytes free"}, found: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := inferUnit(tt.key, tt.hints)
+			if found != tt.found || got != tt.want {
+				t.Fatalf("inferUnit(%q, %+v) = %+v, %t; want %+v, %t", tt.key, tt.hints, got, found, tt.want, tt.found)
+			}
+		})
+	}
+}
+
+func TestDescriptorForMetric_InfersUnitFromHints(t *testing.T) {
+	d := descriptorForMetric(resourceTypeManagedDatabase, "", "steal_time", MetricsHints{Title: "CPU steal %"}, nil)
+	if d.Name != "upcloud.managed_database.steal.time" || d.Unit != "1" || !d.PercentToRatio {
+		t.Fatalf("expected a normalized ratio named after the key, got %+v", d)
+	}
+
+	d = descriptorForMetric(resourceTypeManagedDatabase, "", "swap_usage", MetricsHints{Title: "Swap usage bytes"}, nil)
+	if d.Name != "upcloud.managed_database.swap.usage" || d.Unit != "By" || d.PercentToRatio {
+		t.Fatalf("expected a *_usage key in bytes not to be a utilization, got %+v", d)
+	}
+
+	// Built-in metrics keep their own unit whatever the hints say.
+	d = descriptorForMetric(resourceTypeManagedDatabase, "", "diskio_reads", MetricsHints{Unit: "bytes"}, nil)
+	if d.Name != metadata.MetricsInfo.UpcloudManagedDatabaseDiskIoReadOperations.Name || d.Unit != "" {
+		t.Fatalf("expected the built-in descriptor, got %+v", d)
+	}
+}
+
+func TestMetricsHints_DecodesUnitAndFormat(t *testing.T) {
+	var item MetricsItem
+	if err := json.Unmarshal([]byte(`{"hints":{"title":"WAL size","unit":"bytes","format":"number"}}`), &item); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	want := MetricsHints{Title: "WAL size", Unit: "bytes", Format: "number"}
+	if item.Hints != want {
+		t.Fatalf("unexpected hints %+v, want %+v", item.Hints, want)
+	}
+}
+
+func TestScrapeMetrics_InferredUnits(t *testing.T) {
+	cols := []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}}
+	rows := [][]any{{"2026-02-21T08:00:00Z", 40.0}}
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+	}
+	client := &fakeClient{dbResp: MetricsResponse{
+		"steal_time":    {Hints: MetricsHints{Title: "CPU steal %"}, Data: MetricsData{Cols: cols, Rows: rows}},
+		"wal_size":      {Hints: MetricsHints{Unit: "bytes"}, Data: MetricsData{Cols: cols, Rows: rows}},
+		"diskio_merged": {Hints: MetricsHints{Title: "Disk iops (merged)"}, Data: MetricsData{Cols: cols, Rows: rows}},
+	}}
+
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
+	if err != nil {
+		t.Fatalf("unexpected scrape error: %v", err)
+	}
+	byName := metricsByName(metrics)
+	for name, want := range map[string]struct {
+		unit  string
+		value float64
+	}{
+		"upcloud.managed_database.steal.time":    {unit: "1", value: 0.4},
+		"upcloud.managed_database.wal.size":      {unit: "By", value: 40},
+		"upcloud.managed_database.diskio.merged": {unit: "{operation}/s", value: 40},
+	} {
+		m, ok := byName[name]
+		if !ok {
+			t.Fatalf("expected metric %s, got %v", name, byName)
+		}
+		dp := m.Gauge().DataPoints().At(0)
+		if m.Unit() != want.unit || dp.DoubleValue() != want.value {
+			t.Fatalf("%s: got unit %q value %v, want %q %v", name, m.Unit(), dp.DoubleValue(), want.unit, want.value)
+		}
+	}
+	steal := byName["upcloud.managed_database.steal.time"].Gauge().DataPoints().At(0)
+	if normalization, _ := steal.Attributes().Get("upcloud.value.normalization"); normalization.Str() != "percent_to_ratio" {
+		t.Fatalf("expected the percent_to_ratio marker, got %q", normalization.Str())
+	}
+}
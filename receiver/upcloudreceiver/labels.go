@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"path"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// parseLabels reads the labels of a resource. The API reports them as a list
+// of {"key": ..., "value": ...} objects; a plain key/value object is accepted
+// as well. Resources without labels return nil.
+func parseLabels(value any) map[string]string {
+	var labels map[string]string
+	put := func(key string, value string) {
+		if key == "" {
+			return
+		}
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[key] = value
+	}
+
+	switch raw := value.(type) {
+	case []any:
+		for _, item := range raw {
+			if obj, ok := item.(map[string]any); ok {
+				put(stringField(obj, "key"), stringField(obj, "value"))
+			}
+		}
+	case map[string]any:
+		for key := range raw {
+			put(key, stringField(raw, key))
+		}
+	}
+	return labels
+}
+
+// putLabels adds the labels selected by cfg to attrs as <prefix><key>.
+func putLabels(attrs pcommon.Map, labels map[string]string, cfg LabelsConfig) {
+	if !cfg.Enabled {
+		return
+	}
+	for key, value := range labels {
+		if len(cfg.Include) > 0 && !matchesAny(cfg.Include, key) {
+			continue
+		}
+		if matchesAny(cfg.Exclude, key) {
+			continue
+		}
+		attrs.PutStr(cfg.Prefix+key, value)
+	}
+}
+
+// matchesAny reports whether value matches one of the glob patterns.
+// Patterns are checked by Config.Validate, so match errors are not expected.
+func matchesAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
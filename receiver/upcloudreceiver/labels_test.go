@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"reflect"
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+func TestParseLabels(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  map[string]string
+	}{
+		{
+			name: "key value list",
+			value: []any{
+				map[string]any{"key": "team", "value": "payments"},
+				map[string]any{"key": "", "value": "ignored"},
+				"not an object",
+			},
+			want: map[string]string{"team": "payments"},
+		},
+		{
+			name:  "object",
+			value: map[string]any{"env": "prod"},
+			want:  map[string]string{"env": "prod"},
+		},
+		{
+			name:  "missing",
+			value: nil,
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseLabels(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPutLabels(t *testing.T) {
+	labels := map[string]string{
+		"team":        "payments",
+		"env":         "prod",
+		"cost-center": "cc-42",
+		"cost-owner":  "finance",
+	}
+	tests := []struct {
+		name string
+		cfg  LabelsConfig
+		want map[string]any
+	}{
+		{
+			name: "disabled",
+			cfg:  LabelsConfig{Prefix: defaultLabelPrefix},
+			want: map[string]any{},
+		},
+		{
+			name: "every label",
+			cfg:  LabelsConfig{Enabled: true, Prefix: defaultLabelPrefix},
+			want: map[string]any{
+				"upcloud.label.team":        "payments",
+				"upcloud.label.env":         "prod",
+				"upcloud.label.cost-center": "cc-42",
+				"upcloud.label.cost-owner":  "finance",
+			},
+		},
+		{
+			name: "include and exclude",
+			cfg: LabelsConfig{
+				Enabled: true,
+				Include: []string{"team", "cost-*"},
+				Exclude: []string{"cost-owner"},
+				Prefix:  "label.",
+			},
+			want: map[string]any{
+				"label.team":        "payments",
+				"label.cost-center": "cc-42",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := pcommon.NewMap()
+			putLabels(attrs, labels, tt.cfg)
+			if got := attrs.AsRaw(); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("unexpected attributes:\n got %v\nwant %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildResource_Labels(t *testing.T) {
+	info := ResourceInfo{UUID: "db-1", Labels: map[string]string{"team": "payments"}}
+	cfg := LabelsConfig{Enabled: true, Prefix: defaultLabelPrefix}
+
+	res := buildResource(metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig()), resourceTypeManagedDatabase, info, cfg)
+	if team, ok := res.Attributes().Get("upcloud.label.team"); !ok || team.Str() != "payments" {
+		t.Fatalf("expected upcloud.label.team=payments, got %v", res.Attributes().AsRaw())
+	}
+	if uuid, _ := res.Attributes().Get("upcloud.resource.uuid"); uuid.Str() != "db-1" {
+		t.Fatalf("expected the generated attributes to be kept, got %v", res.Attributes().AsRaw())
+	}
+}
@@ -6,94 +6,274 @@ package upcloudreceiver
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
 )
 
 var invalidMetricChars = regexp.MustCompile(`[^a-z0-9]+`)
 
+const (
+	instrumentGauge = "gauge"
+	// instrumentSum is a monotonic cumulative sum.
+	instrumentSum = "sum"
+)
+
+// metricDescriptor maps an UpCloud metric key to an OpenTelemetry metric.
+// Metrics defined in metadata.yaml have a record function and are emitted
+// through the generated MetricsBuilder; overridden keys and keys the API
+// adds later are built from Name, Unit, Description and Instrument.
 type metricDescriptor struct {
 	Name           string
 	Unit           string
+	Description    string
 	PercentToRatio bool
+	// Instrument is instrumentGauge or instrumentSum; empty is a gauge.
+	Instrument string
+	record     recordFunc
 }
 
+// recordFunc records one data point of a metric defined in metadata.yaml.
+type recordFunc func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes)
+
 var managedDatabaseMetricDescriptors = map[string]metricDescriptor{
 	"cpu_usage": {
-		Name:           "upcloud.managed_database.cpu.utilization",
-		Unit:           "1",
+		Name:           metadata.MetricsInfo.UpcloudManagedDatabaseCPUUtilization.Name,
 		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseCPUUtilizationDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
 	},
 	"mem_usage": {
-		Name:           "upcloud.managed_database.memory.utilization",
-		Unit:           "1",
+		Name:           metadata.MetricsInfo.UpcloudManagedDatabaseMemoryUtilization.Name,
 		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseMemoryUtilizationDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
 	},
 	"disk_usage": {
-		Name:           "upcloud.managed_database.disk.utilization",
-		Unit:           "1",
+		Name:           metadata.MetricsInfo.UpcloudManagedDatabaseDiskUtilization.Name,
 		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseDiskUtilizationDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
 	},
 	"load_average": {
-		Name: "upcloud.managed_database.system.load_average",
-		Unit: "1",
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseSystemLoadAverage.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseSystemLoadAverageDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
 	},
 	"diskio_reads": {
-		Name: "upcloud.managed_database.disk.io.read_operations",
-		Unit: "{operation}/s",
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseDiskIoReadOperations.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseDiskIoReadOperationsDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
 	},
 	"diskio_writes": {
-		Name: "upcloud.managed_database.disk.io.write_operations",
-		Unit: "{operation}/s",
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseDiskIoWriteOperations.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseDiskIoWriteOperationsDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
 	},
 	"net_receive": {
-		Name: "upcloud.managed_database.network.receive",
-		Unit: "By/s",
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseNetworkReceive.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseNetworkReceiveDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
 	},
 	"net_send": {
-		Name: "upcloud.managed_database.network.transmit",
-		Unit: "By/s",
+		Name: metadata.MetricsInfo.UpcloudManagedDatabaseNetworkTransmit.Name,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedDatabaseNetworkTransmitDataPoint(ts, value, metricKey, attrs.series, attrs.nodeRole, attrs.nodeName)
+		},
 	},
 }
 
 var managedLoadBalancerMetricDescriptors = map[string]metricDescriptor{
 	"cpu_usage": {
-		Name:           "upcloud.managed_load_balancer.cpu.utilization",
-		Unit:           "1",
+		Name:           metadata.MetricsInfo.UpcloudManagedLoadBalancerCPUUtilization.Name,
 		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedLoadBalancerCPUUtilizationDataPoint(ts, value, metricKey, attrs.series, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
 	},
 	"mem_usage": {
-		Name:           "upcloud.managed_load_balancer.memory.utilization",
-		Unit:           "1",
+		Name:           metadata.MetricsInfo.UpcloudManagedLoadBalancerMemoryUtilization.Name,
 		PercentToRatio: true,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedLoadBalancerMemoryUtilizationDataPoint(ts, value, metricKey, attrs.series, metadata.AttributeUpcloudValueNormalizationPercentToRatio)
+		},
+	},
+	// Snapshot totals of frontends and backends.
+	"frontend.total_http_requests": {
+		Name:       metadata.MetricsInfo.UpcloudManagedLoadBalancerFrontendHTTPRequests.Name,
+		Instrument: instrumentSum,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedLoadBalancerFrontendHTTPRequestsDataPoint(ts, value, metricKey, attrs.series)
+		},
+	},
+	"frontend.total_request_bytes": {
+		Name:       metadata.MetricsInfo.UpcloudManagedLoadBalancerFrontendRequestBytes.Name,
+		Instrument: instrumentSum,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedLoadBalancerFrontendRequestBytesDataPoint(ts, value, metricKey, attrs.series)
+		},
+	},
+	"frontend.total_response_bytes": {
+		Name:       metadata.MetricsInfo.UpcloudManagedLoadBalancerFrontendResponseBytes.Name,
+		Instrument: instrumentSum,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedLoadBalancerFrontendResponseBytesDataPoint(ts, value, metricKey, attrs.series)
+		},
+	},
+	"backend.total_request_bytes": {
+		Name:       metadata.MetricsInfo.UpcloudManagedLoadBalancerBackendRequestBytes.Name,
+		Instrument: instrumentSum,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedLoadBalancerBackendRequestBytesDataPoint(ts, value, metricKey, attrs.series)
+		},
+	},
+	"backend.total_response_bytes": {
+		Name:       metadata.MetricsInfo.UpcloudManagedLoadBalancerBackendResponseBytes.Name,
+		Instrument: instrumentSum,
+		record: func(mb *metadata.MetricsBuilder, ts pcommon.Timestamp, value float64, metricKey string, attrs seriesAttributes) {
+			mb.RecordUpcloudManagedLoadBalancerBackendResponseBytesDataPoint(ts, value, metricKey, attrs.series)
+		},
 	},
 }
 
-func descriptorForMetric(resourceType string, metricKey string) metricDescriptor {
-	metricKey = strings.TrimSpace(metricKey)
-	if resourceType == resourceTypeManagedDatabase {
-		if descriptor, ok := managedDatabaseMetricDescriptors[metricKey]; ok {
-			return descriptor
+// builtinMetricDescriptors returns the compiled in descriptor tables of a
+// resource, in order of precedence: the table of the database engine, then
+// the table of the resource type.
+func builtinMetricDescriptors(resourceType string, databaseType string) []map[string]metricDescriptor {
+	switch resourceType {
+	case resourceTypeManagedDatabase:
+		if engine, ok := engineMetricDescriptors[databaseType]; ok {
+			return []map[string]metricDescriptor{engine, managedDatabaseMetricDescriptors}
 		}
+		return []map[string]metricDescriptor{managedDatabaseMetricDescriptors}
+	case resourceTypeManagedLoadBalancer:
+		return []map[string]metricDescriptor{managedLoadBalancerMetricDescriptors}
+	default:
+		return nil
+	}
+}
+
+// descriptorForMetric returns the descriptor of metricKey. A configured
+// override takes precedence over the built-in tables, which take precedence
+// over the generic fallback. databaseType selects the engine table of a
+// managed database; it is empty when discovery did not report it. The unit of
+// the fallback is inferred from hints and the key.
+func descriptorForMetric(resourceType string, databaseType string, metricKey string, hints MetricsHints, overrides map[string]MetricOverrideConfig) metricDescriptor {
+	metricKey = strings.TrimSpace(metricKey)
+	if override, ok := overrides[metricKey]; ok {
+		return override.descriptor()
 	}
-	if resourceType == resourceTypeManagedLoadBalancer {
-		if descriptor, ok := managedLoadBalancerMetricDescriptors[metricKey]; ok {
+	for _, descriptors := range builtinMetricDescriptors(resourceType, databaseType) {
+		if descriptor, ok := descriptors[metricKey]; ok {
 			return descriptor
 		}
 	}
 
-	if strings.HasSuffix(metricKey, "_usage") {
+	descriptor := metricDescriptor{
+		Name: fmt.Sprintf("upcloud.%s.%s", resourceType, sanitizeMetricPath(metricKey)),
+		Unit: "1",
+	}
+	if unit, ok := inferUnit(metricKey, hints); ok {
+		descriptor.Unit = unit.Unit
+		descriptor.PercentToRatio = unit.PercentToRatio
+	}
+	// A percentage named *_usage is a utilization.
+	if descriptor.PercentToRatio && strings.HasSuffix(metricKey, "_usage") {
 		base := strings.TrimSuffix(metricKey, "_usage")
-		return metricDescriptor{
-			Name:           fmt.Sprintf("upcloud.%s.%s.utilization", resourceType, sanitizeMetricPath(base)),
-			Unit:           "1",
-			PercentToRatio: true,
-		}
+		descriptor.Name = fmt.Sprintf("upcloud.%s.%s.utilization", resourceType, sanitizeMetricPath(base))
 	}
+	// Snapshot fields named total_* are running totals.
+	if strings.HasPrefix(metricKey[strings.LastIndex(metricKey, ".")+1:], "total_") {
+		descriptor.Instrument = instrumentSum
+	}
+	return descriptor
+}
 
+// descriptor returns the descriptor an override defines. Overridden metrics
+// are not recorded through the MetricsBuilder.
+func (o MetricOverrideConfig) descriptor() metricDescriptor {
+	unit := o.Unit
+	if unit == "" {
+		unit = "1"
+	}
 	return metricDescriptor{
-		Name: fmt.Sprintf("upcloud.%s.%s", resourceType, sanitizeMetricPath(metricKey)),
-		Unit: "1",
+		Name:           o.Name,
+		Unit:           unit,
+		Description:    o.Description,
+		PercentToRatio: o.PercentToRatio,
+		Instrument:     strings.ToLower(strings.TrimSpace(o.Type)),
+	}
+}
+
+// validateMetricOverrides checks the overrides of one resource block. Every
+// override needs a name, and no two metrics of the block, overridden or
+// built-in, may be emitted under the same name.
+func validateMetricOverrides(resourceType string, overrides map[string]MetricOverrideConfig) error {
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	names := make(map[string]string, len(overrides))
+	for _, key := range keys {
+		override := overrides[key]
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("metric_overrides keys must not be empty")
+		}
+		if strings.TrimSpace(override.Name) == "" {
+			return fmt.Errorf("metric_overrides[%s].name is required", key)
+		}
+		switch strings.ToLower(strings.TrimSpace(override.Type)) {
+		case "", instrumentGauge, instrumentSum:
+		default:
+			return fmt.Errorf("metric_overrides[%s].type must be one of: gauge, sum", key)
+		}
+		if other, dup := names[override.Name]; dup {
+			return fmt.Errorf("metric_overrides[%s].name %q is already used by metric_overrides[%s]", key, override.Name, other)
+		}
+		names[override.Name] = key
+	}
+
+	// The engine of a database is only known at scrape time, so the names of
+	// every engine table are reserved.
+	tables := builtinMetricDescriptors(resourceType, "")
+	if resourceType == resourceTypeManagedDatabase {
+		databaseTypes := make([]string, 0, len(engineMetricDescriptors))
+		for databaseType := range engineMetricDescriptors {
+			databaseTypes = append(databaseTypes, databaseType)
+		}
+		sort.Strings(databaseTypes)
+		for _, databaseType := range databaseTypes {
+			tables = append(tables, engineMetricDescriptors[databaseType])
+		}
+	}
+	for _, builtins := range tables {
+		builtinKeys := make([]string, 0, len(builtins))
+		for key := range builtins {
+			builtinKeys = append(builtinKeys, key)
+		}
+		sort.Strings(builtinKeys)
+		for _, key := range builtinKeys {
+			if _, overridden := overrides[key]; overridden {
+				continue
+			}
+			if other, dup := names[builtins[key].Name]; dup {
+				return fmt.Errorf("metric_overrides[%s].name %q is already used by the built-in metric %s", other, builtins[key].Name, key)
+			}
+		}
 	}
+	return nil
 }
 
 func sanitizeMetricPath(metricKey string) string {
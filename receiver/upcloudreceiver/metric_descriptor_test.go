@@ -3,15 +3,24 @@
 
 package upcloudreceiver
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
 
 func TestDescriptorForMetric_KnownManagedDatabaseMetric(t *testing.T) {
-	d := descriptorForMetric(resourceTypeManagedDatabase, "cpu_usage")
+	d := descriptorForMetric(resourceTypeManagedDatabase, "", "cpu_usage", MetricsHints{}, nil)
 	if d.Name != "upcloud.managed_database.cpu.utilization" {
 		t.Fatalf("unexpected name: %s", d.Name)
 	}
-	if d.Unit != "1" {
-		t.Fatalf("unexpected unit: %s", d.Unit)
+	if d.record == nil {
+		t.Fatalf("expected cpu_usage to be recorded through the metrics builder")
 	}
 	if !d.PercentToRatio {
 		t.Fatalf("expected percent-to-ratio normalization")
@@ -19,7 +28,7 @@ func TestDescriptorForMetric_KnownManagedDatabaseMetric(t *testing.T) {
 }
 
 func TestDescriptorForMetric_UsageFallback(t *testing.T) {
-	d := descriptorForMetric(resourceTypeManagedLoadBalancer, "frontend_usage")
+	d := descriptorForMetric(resourceTypeManagedLoadBalancer, "", "frontend_usage", MetricsHints{}, nil)
 	if d.Name != "upcloud.managed_load_balancer.frontend.utilization" {
 		t.Fatalf("unexpected name: %s", d.Name)
 	}
@@ -29,7 +38,7 @@ func TestDescriptorForMetric_UsageFallback(t *testing.T) {
 }
 
 func TestDescriptorForMetric_GenericFallback(t *testing.T) {
-	d := descriptorForMetric(resourceTypeManagedLoadBalancer, "backend-connections.total")
+	d := descriptorForMetric(resourceTypeManagedLoadBalancer, "", "backend-connections.total", MetricsHints{}, nil)
 	if d.Name != "upcloud.managed_load_balancer.backend.connections.total" {
 		t.Fatalf("unexpected name: %s", d.Name)
 	}
@@ -37,3 +46,236 @@ func TestDescriptorForMetric_GenericFallback(t *testing.T) {
 		t.Fatalf("unexpected unit: %s", d.Unit)
 	}
 }
+
+func TestDescriptorForMetric_KnownMetricsMatchMetadata(t *testing.T) {
+	tables := map[string]map[string]metricDescriptor{
+		resourceTypeManagedDatabase:     managedDatabaseMetricDescriptors,
+		resourceTypeManagedLoadBalancer: managedLoadBalancerMetricDescriptors,
+	}
+	for databaseType, descriptors := range engineMetricDescriptors {
+		tables[resourceTypeManagedDatabase+"/"+databaseType] = descriptors
+	}
+	for resourceType, descriptors := range tables {
+		for key, d := range descriptors {
+			if d.record == nil {
+				t.Fatalf("%s %s: missing record function", resourceType, key)
+			}
+			mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type))
+			d.record(mb, 0, 1, key, seriesAttributes{series: "primary", nodeRole: "primary", nodeName: "db-1-1"})
+			metrics := mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			if metrics.Len() != 1 || metrics.At(0).Name() != d.Name {
+				t.Fatalf("%s %s: expected the builder to emit %s", resourceType, key, d.Name)
+			}
+		}
+	}
+}
+
+func knownAndUnknownPayload() MetricsResponse {
+	cols := []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}}
+	rows := [][]any{{"2026-02-21T08:00:00Z", 50.0}}
+	return MetricsResponse{
+		"cpu_usage":       {Data: MetricsData{Cols: cols, Rows: rows}},
+		"net_receive":     {Data: MetricsData{Cols: cols, Rows: rows}},
+		"replication_lag": {Hints: MetricsHints{Title: "Replication lag"}, Data: MetricsData{Cols: cols, Rows: rows}},
+	}
+}
+
+func metricsByName(metrics pmetric.Metrics) map[string]pmetric.Metric {
+	byName := make(map[string]pmetric.Metric)
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		sms := metrics.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			for k := 0; k < sms.At(j).Metrics().Len(); k++ {
+				m := sms.At(j).Metrics().At(k)
+				byName[m.Name()] = m
+			}
+		}
+	}
+	return byName
+}
+
+func TestScrapeMetrics_MetricsBuilderToggles(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+	}
+	cfg.MetricsBuilderConfig.Metrics.UpcloudManagedDatabaseNetworkReceive.Enabled = false
+	cfg.MetricsBuilderConfig.ResourceAttributes.CloudProvider.Enabled = false
+	client := &fakeClient{dbResp: knownAndUnknownPayload()}
+
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
+	if err != nil {
+		t.Fatalf("unexpected scrape error: %v", err)
+	}
+	if metrics.ResourceMetrics().Len() != 1 {
+		t.Fatalf("expected 1 resource, got %d", metrics.ResourceMetrics().Len())
+	}
+	byName := metricsByName(metrics)
+	if _, ok := byName["upcloud.managed_database.cpu.utilization"]; !ok {
+		t.Fatalf("expected the enabled cpu metric, got %v", byName)
+	}
+	if _, ok := byName["upcloud.managed_database.network.receive"]; ok {
+		t.Fatalf("expected the disabled network metric to be dropped")
+	}
+	unknown, ok := byName["upcloud.managed_database.replication.lag"]
+	if !ok {
+		t.Fatalf("expected unknown keys to use the fallback path, got %v", byName)
+	}
+	if unknown.Description() != "Replication lag" {
+		t.Fatalf("expected the fallback description from the API hints, got %q", unknown.Description())
+	}
+
+	attrs := metrics.ResourceMetrics().At(0).Resource().Attributes()
+	if _, ok := attrs.Get("cloud.provider"); ok {
+		t.Fatalf("expected the disabled resource attribute to be dropped")
+	}
+	if uuid, _ := attrs.Get("upcloud.resource.uuid"); uuid.Str() != "db-uuid" {
+		t.Fatalf("expected upcloud.resource.uuid=db-uuid, got %q", uuid.Str())
+	}
+	if scope := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().Name(); scope != metadata.ScopeName {
+		t.Fatalf("unexpected scope name %q", scope)
+	}
+}
+
+func TestScrapeMetrics_UnknownOnlyPayloadKeepsResource(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}, Metrics: []string{"replication_lag"}},
+	}
+	client := &fakeClient{dbResp: knownAndUnknownPayload()}
+
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
+	if err != nil {
+		t.Fatalf("unexpected scrape error: %v", err)
+	}
+	byName := metricsByName(metrics)
+	if len(byName) != 1 {
+		t.Fatalf("expected only the fallback metric, got %v", byName)
+	}
+	if provider, _ := metrics.ResourceMetrics().At(0).Resource().Attributes().Get("cloud.provider"); provider.Str() != "upcloud" {
+		t.Fatalf("expected resource attributes on a resource without known metrics")
+	}
+}
+
+func TestDescriptorForMetric_OverrideTakesPrecedence(t *testing.T) {
+	overrides := map[string]MetricOverrideConfig{
+		"cpu_usage": {Name: "db.cpu", Unit: "%", Description: "CPU"},
+	}
+	d := descriptorForMetric(resourceTypeManagedDatabase, "", "cpu_usage", MetricsHints{}, overrides)
+	if d.Name != "db.cpu" || d.Unit != "%" || d.Description != "CPU" {
+		t.Fatalf("unexpected descriptor: %+v", d)
+	}
+	if d.record != nil || d.PercentToRatio {
+		t.Fatalf("expected the override to replace the built-in descriptor")
+	}
+
+	d = descriptorForMetric(resourceTypeManagedDatabase, "", "replication_lag", MetricsHints{}, map[string]MetricOverrideConfig{
+		"replication_lag": {Name: "upcloud.managed_database.replication.lag"},
+	})
+	if d.Unit != "1" || d.Instrument != "" {
+		t.Fatalf("expected the default unit and instrument, got %+v", d)
+	}
+}
+
+func TestValidateMetricOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]MetricOverrideConfig
+		wantErr   bool
+	}{
+		{name: "none"},
+		{
+			name: "valid",
+			overrides: map[string]MetricOverrideConfig{
+				"replication_lag": {Name: "upcloud.managed_database.replication.lag", Unit: "s"},
+				"bytes_total":     {Name: "upcloud.managed_database.bytes", Unit: "By", Type: "sum"},
+			},
+		},
+		{
+			name: "renamed built-in frees its name",
+			overrides: map[string]MetricOverrideConfig{
+				"cpu_usage":   {Name: "db.cpu"},
+				"cpu_usage_2": {Name: metadata.MetricsInfo.UpcloudManagedDatabaseCPUUtilization.Name},
+			},
+		},
+		{
+			name:      "missing name",
+			overrides: map[string]MetricOverrideConfig{"replication_lag": {Unit: "s"}},
+			wantErr:   true,
+		},
+		{
+			name:      "unknown type",
+			overrides: map[string]MetricOverrideConfig{"replication_lag": {Name: "lag", Type: "histogram"}},
+			wantErr:   true,
+		},
+		{
+			name: "duplicate override names",
+			overrides: map[string]MetricOverrideConfig{
+				"replication_lag":   {Name: "lag"},
+				"replication_delay": {Name: "lag"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "name of a built-in metric",
+			overrides: map[string]MetricOverrideConfig{
+				"replication_lag": {Name: metadata.MetricsInfo.UpcloudManagedDatabaseCPUUtilization.Name},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMetricOverrides(resourceTypeManagedDatabase, tt.overrides)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateMetricOverrides() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScrapeMetrics_MetricOverrides(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases: ManagedDatabaseConfig{
+			Enabled: true,
+			UUIDs:   []string{"db-uuid"},
+			MetricOverrides: map[string]MetricOverrideConfig{
+				"cpu_usage": {Name: "db.cpu.percent", Unit: "%"},
+				"replication_lag": {
+					Name:        "upcloud.managed_database.replication.lag",
+					Unit:        "s",
+					Description: "Replica lag behind the primary",
+					Type:        "sum",
+				},
+			},
+		},
+	}
+	client := &fakeClient{dbResp: knownAndUnknownPayload()}
+
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
+	if err != nil {
+		t.Fatalf("unexpected scrape error: %v", err)
+	}
+	byName := metricsByName(metrics)
+	if _, ok := byName["upcloud.managed_database.cpu.utilization"]; ok {
+		t.Fatalf("expected the overridden built-in metric to be renamed")
+	}
+	cpu, ok := byName["db.cpu.percent"]
+	if !ok {
+		t.Fatalf("expected the overridden cpu metric, got %v", byName)
+	}
+	if cpu.Unit() != "%" || cpu.Gauge().DataPoints().At(0).DoubleValue() != 50 {
+		t.Fatalf("expected the raw percentage, got unit %q value %v", cpu.Unit(), cpu.Gauge().DataPoints().At(0).DoubleValue())
+	}
+	lag := byName["upcloud.managed_database.replication.lag"]
+	if lag.Type() != pmetric.MetricTypeSum || !lag.Sum().IsMonotonic() || lag.Sum().AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+		t.Fatalf("expected a monotonic cumulative sum, got %v", lag.Type())
+	}
+	if lag.Unit() != "s" || lag.Description() != "Replica lag behind the primary" {
+		t.Fatalf("unexpected unit %q or description %q", lag.Unit(), lag.Description())
+	}
+	if _, ok := byName["upcloud.managed_database.network.receive"]; !ok {
+		t.Fatalf("expected metrics without override to be unchanged")
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"strings"
+	"unicode"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	nodeRolePrimary = "primary"
+	nodeRoleReplica = "replica"
+	nodeRoleStandby = "standby"
+)
+
+// normalizeNodeRole maps the role names used by the API and in series labels
+// to primary, replica or standby. Unknown roles return "".
+func normalizeNodeRole(role string) string {
+	switch strings.ToLower(strings.TrimSpace(role)) {
+	case "primary", "master":
+		return nodeRolePrimary
+	case "replica", "read-replica", "read_replica", "slave":
+		return nodeRoleReplica
+	case "standby":
+		return nodeRoleStandby
+	default:
+		return ""
+	}
+}
+
+// seriesNode resolves the node a series column label belongs to. A label
+// that names a node takes the role of that node. Otherwise the role is read
+// from the label ("db-primary", "replica-1") and the name is that of the only
+// node with this role. When no single node matches, the label itself is the
+// name, so different series never share the same attributes.
+func seriesNode(label string, nodes []NodeInfo) (role string, name string) {
+	for _, node := range nodes {
+		if node.Name == label {
+			return normalizeNodeRole(node.Role), node.Name
+		}
+	}
+
+	for _, token := range strings.FieldsFunc(label, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if role = normalizeNodeRole(token); role != "" {
+			break
+		}
+	}
+	if role == "" {
+		return "", label
+	}
+
+	var matches []string
+	for _, node := range nodes {
+		if normalizeNodeRole(node.Role) == role {
+			matches = append(matches, node.Name)
+		}
+	}
+	if len(matches) == 1 {
+		return role, matches[0]
+	}
+	return role, label
+}
+
+// seriesAttributes are the data point attributes of one series (column) of
+// a metrics response.
+type seriesAttributes struct {
+	series   string
+	nodeRole string
+	nodeName string
+}
+
+// newSeriesAttributes resolves the attributes of a series label. Managed
+// database series are resolved to their node and keep the label only when
+// keepSeries is set. Other series, such as the "frontend:web" columns of a
+// load balancer, name no node and keep their label.
+func newSeriesAttributes(label string, resourceType string, nodes []NodeInfo, keepSeries bool) seriesAttributes {
+	if resourceType != resourceTypeManagedDatabase {
+		return seriesAttributes{series: label}
+	}
+	var attrs seriesAttributes
+	attrs.nodeRole, attrs.nodeName = seriesNode(label, nodes)
+	if keepSeries {
+		attrs.series = label
+	}
+	return attrs
+}
+
+// putTo adds the attributes that are set to a data point that is not
+// recorded through the MetricsBuilder.
+func (a seriesAttributes) putTo(attrs pcommon.Map) {
+	if a.series != "" {
+		attrs.PutStr("upcloud.series", a.series)
+	}
+	if a.nodeRole != "" {
+		attrs.PutStr("upcloud.node.role", a.nodeRole)
+	}
+	if a.nodeName != "" {
+		attrs.PutStr("upcloud.node.name", a.nodeName)
+	}
+}
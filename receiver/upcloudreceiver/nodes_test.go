@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+func TestSeriesNode(t *testing.T) {
+	nodes := []NodeInfo{
+		{Name: "payments-1", Role: "master"},
+		{Name: "payments-2", Role: "standby"},
+		{Name: "payments-3", Role: "replica"},
+		{Name: "payments-4", Role: "replica"},
+	}
+	tests := []struct {
+		label    string
+		nodes    []NodeInfo
+		wantRole string
+		wantName string
+	}{
+		{label: "payments-2", nodes: nodes, wantRole: "standby", wantName: "payments-2"},
+		{label: "db-primary", nodes: nodes, wantRole: "primary", wantName: "payments-1"},
+		{label: "standby", nodes: nodes, wantRole: "standby", wantName: "payments-2"},
+		// Two replicas: the label cannot be narrowed down to one node.
+		{label: "db-replica", nodes: nodes, wantRole: "replica", wantName: "db-replica"},
+		{label: "db-primary", wantRole: "primary", wantName: "db-primary"},
+		{label: "node-1", nodes: nodes, wantRole: "", wantName: "node-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			role, name := seriesNode(tt.label, tt.nodes)
+			if role != tt.wantRole || name != tt.wantName {
+				t.Fatalf("seriesNode(%q) = %q, %q; want %q, %q", tt.label, role, name, tt.wantRole, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestNewSeriesAttributes(t *testing.T) {
+	nodes := []NodeInfo{{Name: "payments-1", Role: "master"}}
+	tests := []struct {
+		name         string
+		label        string
+		resourceType string
+		keepSeries   bool
+		want         seriesAttributes
+	}{
+		{name: "database", label: "db-primary", resourceType: resourceTypeManagedDatabase, want: seriesAttributes{nodeRole: "primary", nodeName: "payments-1"}},
+		{name: "database keeps series", label: "db-primary", resourceType: resourceTypeManagedDatabase, keepSeries: true, want: seriesAttributes{series: "db-primary", nodeRole: "primary", nodeName: "payments-1"}},
+		{name: "database without role", label: "node-1", resourceType: resourceTypeManagedDatabase, want: seriesAttributes{nodeName: "node-1"}},
+		// Load balancer series name a frontend or backend, not a node.
+		{name: "load balancer", label: "frontend:web", resourceType: resourceTypeManagedLoadBalancer, want: seriesAttributes{series: "frontend:web"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newSeriesAttributes(tt.label, tt.resourceType, nodes, tt.keepSeries); got != tt.want {
+				t.Fatalf("newSeriesAttributes(%q) = %+v, want %+v", tt.label, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScrapeMetrics_NodeAttributes(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, AutoDiscover: true},
+		ManagedLoadBalancers: ManagedLoadBalancerConfig{Enabled: true, UUIDs: []string{"lb-1"}},
+	}
+	response := func(label string, keys ...string) MetricsResponse {
+		resp := MetricsResponse{}
+		for _, key := range keys {
+			resp[key] = MetricsItem{Data: MetricsData{
+				Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: label, Type: "number"}},
+				Rows: [][]any{{"2026-02-21T08:00:00Z", 10.0}},
+			}}
+		}
+		return resp
+	}
+	client := &discoveryClient{
+		fakeClient: fakeClient{
+			// A known and an unknown key of each resource type.
+			dbResp: response("db-1-1 (master)", "cpu_usage", "temp_bytes"),
+			lbResp: response("frontend:web", "frontend.total_http_requests", "frontend.request_rate"),
+		},
+		databases: []ResourceInfo{{UUID: "db-1", Nodes: []NodeInfo{{Name: "db-1-1", Role: "master"}}}},
+	}
+
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
+	if err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	if metrics.ResourceMetrics().Len() != 2 {
+		t.Fatalf("expected 2 resources, got %d", metrics.ResourceMetrics().Len())
+	}
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		rm := metrics.ResourceMetrics().At(i)
+		resourceType, _ := rm.Resource().Attributes().Get("upcloud.resource.type")
+		want := map[string]any{"upcloud.node.role": "primary", "upcloud.node.name": "db-1-1"}
+		if resourceType.Str() == resourceTypeManagedLoadBalancer {
+			want = map[string]any{"upcloud.series": "frontend:web"}
+		}
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		if ms.Len() != 2 {
+			t.Fatalf("%s: expected 2 metrics, got %d", resourceType.Str(), ms.Len())
+		}
+		for j := 0; j < ms.Len(); j++ {
+			dps, _ := numberDataPoints(ms.At(j))
+			attrs := dps.At(0).Attributes().AsRaw()
+			delete(attrs, "upcloud.metric.name")
+			delete(attrs, "upcloud.value.normalization")
+			if !reflect.DeepEqual(attrs, want) {
+				t.Fatalf("%s: unexpected attributes %v on %s, want %v", resourceType.Str(), attrs, ms.At(j).Name(), want)
+			}
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const periodAuto = "auto"
+
+// upcloudPeriods lists the metric periods of the UpCloud API from the
+// smallest to the largest, with the time span each one returns.
+var upcloudPeriods = []struct {
+	name string
+	span time.Duration
+}{
+	{name: "hour", span: time.Hour},
+	{name: "day", span: 24 * time.Hour},
+	{name: "week", span: 7 * 24 * time.Hour},
+	{name: "month", span: 30 * 24 * time.Hour},
+	{name: "year", span: 365 * 24 * time.Hour},
+}
+
+// periodSelector resolves `period: auto` per resource: the smallest period
+// that covers the time since the last successful scrape of the resource. It
+// steps up after an outage and back down once the resource is caught up.
+type periodSelector struct {
+	interval time.Duration
+	now      func() time.Time
+
+	mu          sync.Mutex
+	lastSuccess map[string]time.Time
+	// forgotten holds the resources whose history is dropped on commit.
+	forgotten map[string]struct{}
+}
+
+func newPeriodSelector(interval time.Duration) *periodSelector {
+	return &periodSelector{
+		interval:    interval,
+		now:         time.Now,
+		lastSuccess: make(map[string]time.Time),
+		forgotten:   make(map[string]struct{}),
+	}
+}
+
+// resolve returns the period to request for a resource. Periods other than
+// auto are returned unchanged. The newest backfill checkpoint of the resource
+// extends the gap when it is older than the last successful scrape, e.g.
+// after a restart or a rejected batch.
+func (p *periodSelector) resolve(configured string, resourceType string, uuid string, cp *checkpoints) string {
+	if !strings.EqualFold(strings.TrimSpace(configured), periodAuto) {
+		return configured
+	}
+	if p == nil {
+		return upcloudPeriods[0].name
+	}
+
+	now := p.now()
+	gap := p.interval
+	p.mu.Lock()
+	last, ok := p.lastSuccess[resourceKey(resourceType, uuid)]
+	p.mu.Unlock()
+	if exported := cp.resourceLatest(resourceType, uuid); !exported.IsZero() && (!ok || exported.Before(last)) {
+		last, ok = exported, true
+	}
+	if ok && now.Sub(last) > gap {
+		gap = now.Sub(last)
+	}
+	return periodCovering(gap)
+}
+
+// observe records a successful scrape of a resource.
+func (p *periodSelector) observe(resourceType string, uuid string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastSuccess[resourceKey(resourceType, uuid)] = p.now()
+}
+
+// forget drops the history of a resource that is no longer a target once the
+// current batch is committed.
+func (p *periodSelector) forget(resourceType string, uuid string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.forgotten[resourceKey(resourceType, uuid)] = struct{}{}
+}
+
+// commit drops the history of the resources forgotten by the consumed batch.
+func (p *periodSelector) commit() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key := range p.forgotten {
+		delete(p.lastSuccess, key)
+	}
+	clear(p.forgotten)
+}
+
+// discard keeps the history of the resources forgotten by a batch that was
+// not consumed.
+func (p *periodSelector) discard() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	clear(p.forgotten)
+}
+
+// periodCovering returns the smallest period whose span covers gap, or the
+// largest period when none does.
+func periodCovering(gap time.Duration) string {
+	for _, period := range upcloudPeriods {
+		if gap <= period.span {
+			return period.name
+		}
+	}
+	return upcloudPeriods[len(upcloudPeriods)-1].name
+}
+
+func resourceKey(resourceType string, uuid string) string {
+	return resourceType + "/" + uuid
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+func TestPeriodSelector_StepsUpAfterOutageAndBackDown(t *testing.T) {
+	now := time.Date(2026, 2, 21, 8, 0, 0, 0, time.UTC)
+	p := newPeriodSelector(time.Minute)
+	p.now = func() time.Time { return now }
+
+	if got := p.resolve(periodAuto, resourceTypeManagedDatabase, "db-uuid", nil); got != "hour" {
+		t.Fatalf("expected hour before the first scrape, got %s", got)
+	}
+	p.observe(resourceTypeManagedDatabase, "db-uuid")
+
+	for _, tc := range []struct {
+		elapsed time.Duration
+		want    string
+	}{
+		{elapsed: time.Minute, want: "hour"},
+		{elapsed: 3 * time.Hour, want: "day"},
+		{elapsed: 2 * 24 * time.Hour, want: "week"},
+		{elapsed: 10 * 24 * time.Hour, want: "month"},
+		{elapsed: 90 * 24 * time.Hour, want: "year"},
+		{elapsed: 2 * 365 * 24 * time.Hour, want: "year"},
+	} {
+		now = time.Date(2026, 2, 21, 8, 0, 0, 0, time.UTC).Add(tc.elapsed)
+		if got := p.resolve(periodAuto, resourceTypeManagedDatabase, "db-uuid", nil); got != tc.want {
+			t.Fatalf("after %s: expected %s, got %s", tc.elapsed, tc.want, got)
+		}
+	}
+
+	p.observe(resourceTypeManagedDatabase, "db-uuid")
+	now = now.Add(time.Minute)
+	if got := p.resolve(periodAuto, resourceTypeManagedDatabase, "db-uuid", nil); got != "hour" {
+		t.Fatalf("expected hour once caught up, got %s", got)
+	}
+}
+
+func TestPeriodSelector_UsesCollectionIntervalAndCheckpoints(t *testing.T) {
+	now := time.Date(2026, 2, 21, 8, 0, 0, 0, time.UTC)
+	p := newPeriodSelector(2 * time.Hour)
+	p.now = func() time.Time { return now }
+
+	if got := p.resolve(periodAuto, resourceTypeManagedLoadBalancer, "lb-uuid", nil); got != "day" {
+		t.Fatalf("expected day to cover a 2h collection interval, got %s", got)
+	}
+	if got := p.resolve("week", resourceTypeManagedLoadBalancer, "lb-uuid", nil); got != "week" {
+		t.Fatalf("expected explicit period to be kept, got %s", got)
+	}
+
+	cp := newCheckpoints()
+	cp.advance(seriesKey{ResourceType: resourceTypeManagedLoadBalancer, ResourceUUID: "lb-uuid", Metric: "frontend_usage", Series: "web"}, now.Add(-5*24*time.Hour))
+	cp.commit()
+	if got := p.resolve(periodAuto, resourceTypeManagedLoadBalancer, "lb-uuid", cp); got != "week" {
+		t.Fatalf("expected week to cover a 5 day old checkpoint, got %s", got)
+	}
+
+	// A series that stopped being returned keeps its old checkpoint; it must
+	// not pin the resource to a large period once other series caught up.
+	cp.advance(seriesKey{ResourceType: resourceTypeManagedLoadBalancer, ResourceUUID: "lb-uuid", Metric: "frontend_usage", Series: "api"}, now.Add(-time.Minute))
+	cp.commit()
+	if got := p.resolve(periodAuto, resourceTypeManagedLoadBalancer, "lb-uuid", cp); got != "day" {
+		t.Fatalf("expected the collection interval period once a series is current, got %s", got)
+	}
+}
+
+// periodClient records the period requested per UUID.
+type periodClient struct {
+	fakeClient
+	periods map[string]string
+}
+
+func (c *periodClient) GetManagedDatabaseMetrics(_ context.Context, uuid string, period string) (MetricsResponse, error) {
+	c.periods[uuid] = period
+	return c.dbResp, nil
+}
+
+func TestScrapeMetrics_AutoPeriod(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}, Period: periodAuto},
+	}
+	cfg.CollectionInterval = time.Minute
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+	now := time.Date(2026, 2, 21, 8, 0, 0, 0, time.UTC)
+	state.periods.now = func() time.Time { return now }
+	client := &periodClient{periods: map[string]string{}}
+
+	if _, err := scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop()); err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	if got := client.periods["db-uuid"]; got != "hour" {
+		t.Fatalf("expected hour on the first scrape, got %s", got)
+	}
+
+	now = now.Add(6 * time.Hour)
+	if _, err := scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop()); err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	if got := client.periods["db-uuid"]; got != "day" {
+		t.Fatalf("expected day after a 6h gap, got %s", got)
+	}
+}
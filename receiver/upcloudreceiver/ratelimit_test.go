@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+func TestRateLimiter_DisabledNeverBlocks(t *testing.T) {
+	l := newRateLimiter(0, 0)
+	if l != nil {
+		t.Fatalf("expected nil limiter when requests_per_second is 0")
+	}
+	if waited, err := l.wait(context.Background()); err != nil || waited != 0 {
+		t.Fatalf("expected nil limiter to pass through, got waited=%s err=%v", waited, err)
+	}
+}
+
+func TestRateLimiter_BurstThenThrottle(t *testing.T) {
+	current := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	l := newRateLimiter(10, 2)
+	l.now = func() time.Time { return current }
+
+	for i := 0; i < 2; i++ {
+		if waited, err := l.wait(context.Background()); err != nil || waited != 0 {
+			t.Fatalf("burst request %d should not wait: waited=%s err=%v", i, waited, err)
+		}
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), current.Add(50*time.Millisecond))
+	defer cancel()
+	if _, err := l.wait(ctx); err == nil {
+		t.Fatalf("expected deadline error when the next token is 100ms away")
+	}
+
+	// The failed reservation must be released: after 100ms one token is available again.
+	current = current.Add(100 * time.Millisecond)
+	if waited, err := l.wait(context.Background()); err != nil || waited != 0 {
+		t.Fatalf("expected refilled token, got waited=%s err=%v", waited, err)
+	}
+}
+
+func TestHTTPClientRateLimit_PaginationIsThrottled(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			_, _ = w.Write([]byte(`[{"uuid":"db-1"}]`))
+		case "1":
+			_, _ = w.Write([]byte(`[{"uuid":"db-2"}]`))
+		default:
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewHTTPClient(context.Background(), APIConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: server.URL,
+			Timeout:  2 * time.Second,
+		},
+		Token:             "fixture-token",
+		RequestsPerSecond: 20,
+		Burst:             1,
+	}, defaultLoadBalancerMetricsTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		t.Fatalf("new http client: %v", err)
+	}
+
+	start := time.Now()
+	resources, err := client.ListManagedDatabases(context.Background(), "/1.3/database", 1)
+	if err != nil {
+		t.Fatalf("list managed databases: %v", err)
+	}
+	if len(resources) != 2 || calls.Load() != 3 {
+		t.Fatalf("unexpected discovery result: resources=%v calls=%d", resources, calls.Load())
+	}
+	// Three pages at 20 req/s with burst 1 need at least two 50ms waits.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("expected paginated discovery to be throttled, took %s", elapsed)
+	}
+}
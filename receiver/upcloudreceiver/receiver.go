@@ -5,100 +5,125 @@ package upcloudreceiver
 
 import (
 	"context"
-	"sync"
-	"time"
+	"fmt"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
 	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
 )
 
-type metricsReceiver struct {
+// upcloudScraper scrapes every configured account on each collection cycle.
+type upcloudScraper struct {
 	cfg      *Config
 	settings receiver.Settings
-	next     consumer.Metrics
-	client   Client
-
-	cancel context.CancelFunc
-	wg     sync.WaitGroup
+	accounts []accountScraper
+	storage  storage.Client
 }
 
-func newMetricsReceiver(cfg *Config, settings receiver.Settings, next consumer.Metrics, client Client) receiver.Metrics {
-	return &metricsReceiver{
+// newMetricsReceiver creates the receiver. Scheduling (collection_interval,
+// initial_delay, timeout) and scrape telemetry are handled by the
+// scraperhelper controller. A non-nil client is used for the top-level
+// account; otherwise clients are built in Start.
+func newMetricsReceiver(cfg *Config, settings receiver.Settings, next consumer.Metrics, client Client) (receiver.Metrics, error) {
+	s := &upcloudScraper{
 		cfg:      cfg,
 		settings: settings,
-		next:     next,
-		client:   client,
+		storage:  storage.NewNopClient(),
+	}
+	if client != nil {
+		s.accounts = []accountScraper{newAccountScraper("", cfg, client, settings)}
 	}
-}
-
-func (r *metricsReceiver) Start(_ context.Context, _ component.Host) error {
-	ctx, cancel := context.WithCancel(context.Background())
-	r.cancel = cancel
 
-	r.wg.Add(1)
-	go func() {
-		defer r.wg.Done()
-		r.run(ctx)
-	}()
-	return nil
+	sc, err := scraper.NewMetrics(s.scrape, scraper.WithStart(s.start), scraper.WithShutdown(s.shutdown))
+	if err != nil {
+		return nil, err
+	}
+	consume, err := consumer.NewMetrics(s.consumeFunc(next), consumer.WithCapabilities(next.Capabilities()))
+	if err != nil {
+		return nil, err
+	}
+	return scraperhelper.NewMetricsController(
+		&cfg.ControllerConfig,
+		settings,
+		consume,
+		scraperhelper.AddScraper(metadata.Type, sc),
+	)
 }
 
-func (r *metricsReceiver) Shutdown(ctx context.Context) error {
-	if r.cancel != nil {
-		r.cancel()
+func (s *upcloudScraper) start(ctx context.Context, host component.Host) error {
+	if s.accounts == nil {
+		accounts, err := s.buildAccounts(ctx, host)
+		if err != nil {
+			return err
+		}
+		s.accounts = accounts
 	}
 
-	done := make(chan struct{})
-	go func() {
-		defer close(done)
-		r.wg.Wait()
-	}()
+	client, err := newStorageClient(ctx, host, s.cfg.StorageID, s.settings.ID)
+	if err != nil {
+		return err
+	}
+	s.storage = client
+	s.loadCheckpoints(ctx)
+	return nil
+}
 
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-done:
+func (s *upcloudScraper) shutdown(ctx context.Context) error {
+	if s.storage == nil {
 		return nil
 	}
+	return s.storage.Close(ctx)
 }
 
-func (r *metricsReceiver) run(ctx context.Context) {
-	if r.cfg.InitialDelay > 0 {
-		select {
-		case <-ctx.Done():
-			return
-		case <-time.After(r.cfg.InitialDelay):
+// consumeFunc forwards scraped batches to next and commits the scrape state
+// (backfill checkpoints, staleness target sets) only once next accepted the
+// batch.
+func (s *upcloudScraper) consumeFunc(next consumer.Metrics) consumer.ConsumeMetricsFunc {
+	return func(ctx context.Context, md pmetric.Metrics) error {
+		if err := next.ConsumeMetrics(ctx, md); err != nil {
+			s.discardState()
+			return err
 		}
+		s.commitState(ctx)
+		return nil
 	}
+}
 
-	// Immediate first scrape after initial delay.
-	r.scrapeAndConsume(ctx)
-
-	ticker := time.NewTicker(r.cfg.CollectionInterval)
-	defer ticker.Stop()
+// scrape returns the metrics of every resource that was scraped successfully.
+// Failed resources are reported as a partial scrape error, so the controller
+// still forwards the rest.
+func (s *upcloudScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	return scrapeAccounts(ctx, s.accounts, s.settings.Logger)
+}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			r.scrapeAndConsume(ctx)
+func (s *upcloudScraper) buildAccounts(ctx context.Context, host component.Host) ([]accountScraper, error) {
+	if len(s.cfg.Accounts) == 0 {
+		client, err := NewHTTPClient(ctx, s.cfg.API, s.cfg.ManagedLoadBalancers.MetricsPathTemplate, host, s.settings.TelemetrySettings)
+		if err != nil {
+			return nil, err
 		}
+		return []accountScraper{newAccountScraper("", s.cfg, client, s.settings)}, nil
 	}
-}
 
-func (r *metricsReceiver) scrapeAndConsume(ctx context.Context) {
-	metrics, err := scrapeMetrics(ctx, r.client, r.cfg, r.settings.Logger)
-	if err != nil {
-		r.settings.Logger.Error("UpCloud scrape failed", zap.Error(err))
-		return
-	}
-	if metrics.ResourceMetrics().Len() == 0 {
-		return
-	}
-	if err := r.next.ConsumeMetrics(ctx, metrics); err != nil {
-		r.settings.Logger.Error("Failed to consume UpCloud metrics", zap.Error(err))
+	accounts := make([]accountScraper, 0, len(s.cfg.Accounts))
+	for _, account := range s.cfg.Accounts {
+		name := strings.TrimSpace(account.Name)
+		cfg := s.cfg.forAccount(account)
+		settings := s.settings.TelemetrySettings
+		settings.Logger = settings.Logger.With(zap.String("account", name))
+		client, err := NewHTTPClient(ctx, cfg.API, cfg.ManagedLoadBalancers.MetricsPathTemplate, host, settings)
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %w", name, err)
+		}
+		accounts = append(accounts, newAccountScraper(name, cfg, client, s.settings))
 	}
+	return accounts, nil
 }
@@ -7,15 +7,19 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
-	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/pmetric"
-	"go.opentelemetry.io/collector/receiver"
-	"go.uber.org/zap"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
 )
 
 func TestReceiverIntegration_StartAndConsume(t *testing.T) {
@@ -27,12 +31,14 @@ func TestReceiverIntegration_StartAndConsume(t *testing.T) {
 	defer server.Close()
 
 	cfg := &Config{
-		CollectionInterval: 50 * time.Millisecond,
-		InitialDelay:       0,
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ControllerConfig:     scraperhelper.ControllerConfig{CollectionInterval: 50 * time.Millisecond, InitialDelay: 0},
 		API: APIConfig{
-			Endpoint: server.URL,
-			Token:    "fixture-token",
-			Timeout:  2 * time.Second,
+			ClientConfig: confighttp.ClientConfig{
+				Endpoint: server.URL,
+				Timeout:  2 * time.Second,
+			},
+			Token: "fixture-token",
 		},
 		ManagedDatabases: ManagedDatabaseConfig{
 			Enabled: true,
@@ -41,7 +47,7 @@ func TestReceiverIntegration_StartAndConsume(t *testing.T) {
 		},
 	}
 
-	client, err := NewHTTPClient(cfg.API, cfg.ManagedLoadBalancers.MetricsPathTemplate)
+	client, err := NewHTTPClient(context.Background(), cfg.API, cfg.ManagedLoadBalancers.MetricsPathTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
 	if err != nil {
 		t.Fatalf("new http client: %v", err)
 	}
@@ -52,12 +58,10 @@ func TestReceiverIntegration_StartAndConsume(t *testing.T) {
 		t.Fatalf("new metrics consumer: %v", err)
 	}
 
-	r := newMetricsReceiver(cfg, receiver.Settings{
-		ID: component.MustNewID("upcloud"),
-		TelemetrySettings: component.TelemetrySettings{
-			Logger: zap.NewNop(),
-		},
-	}, next, client)
+	r, err := newMetricsReceiver(cfg, receivertest.NewNopSettings(metadata.Type), next, client)
+	if err != nil {
+		t.Fatalf("new metrics receiver: %v", err)
+	}
 
 	if err := r.Start(context.Background(), nil); err != nil {
 		t.Fatalf("receiver start failed: %v", err)
@@ -85,6 +89,81 @@ func TestReceiverIntegration_StartAndConsume(t *testing.T) {
 	}
 }
 
+func TestReceiverIntegration_PartialScrapeStillConsumes(t *testing.T) {
+	fixture := mustReadFixture(t, "testdata/integration/managed_database_metrics.json")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "db-missing") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"error_code":"DATABASE_NOT_FOUND","error_message":"database not found"}}`))
+			return
+		}
+		_, _ = w.Write(fixture)
+	}))
+	defer server.Close()
+
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ControllerConfig:     scraperhelper.ControllerConfig{CollectionInterval: 50 * time.Millisecond},
+		API: APIConfig{
+			ClientConfig: confighttp.ClientConfig{
+				Endpoint: server.URL,
+				Timeout:  2 * time.Second,
+			},
+			Token: "fixture-token",
+		},
+		ManagedDatabases: ManagedDatabaseConfig{
+			Enabled: true,
+			UUIDs:   []string{"db-missing", "db-uuid"},
+			Period:  "5m",
+		},
+	}
+
+	client, err := NewHTTPClient(context.Background(), cfg.API, cfg.ManagedLoadBalancers.MetricsPathTemplate, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		t.Fatalf("new http client: %v", err)
+	}
+
+	capture := &metricsCapture{}
+	next, err := consumer.NewMetrics(capture.consume)
+	if err != nil {
+		t.Fatalf("new metrics consumer: %v", err)
+	}
+
+	r, err := newMetricsReceiver(cfg, receivertest.NewNopSettings(metadata.Type), next, client)
+	if err != nil {
+		t.Fatalf("new metrics receiver: %v", err)
+	}
+
+	if err := r.Start(context.Background(), nil); err != nil {
+		t.Fatalf("receiver start failed: %v", err)
+	}
+	defer func() {
+		_ = r.Shutdown(context.Background())
+	}()
+
+	deadline := time.Now().Add(500 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		if capture.count() > 0 {
+			break
+		}
+		time.Sleep(25 * time.Millisecond)
+	}
+
+	if capture.count() == 0 {
+		t.Fatalf("expected metrics from the healthy resource to be consumed")
+	}
+
+	rms := capture.first().ResourceMetrics()
+	if rms.Len() != 1 {
+		t.Fatalf("expected 1 resource metrics, got %d", rms.Len())
+	}
+	uuid, _ := rms.At(0).Resource().Attributes().Get("upcloud.resource.uuid")
+	if uuid.Str() != "db-uuid" {
+		t.Fatalf("expected metrics for db-uuid, got %q", uuid.Str())
+	}
+}
+
 type metricsCapture struct {
 	mu      sync.Mutex
 	batches []pmetric.Metrics
@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ResourceInfo is what the UpCloud API reports about a managed resource in
+// its discovery and details payloads. Fields the payload does not carry are
+// left empty.
+type ResourceInfo struct {
+	UUID string
+	// Name is the title of the resource, or its name when it has no title.
+	Name string
+	Zone string
+	Plan string
+	// Type is the database type (pg, mysql, opensearch, valkey); empty for
+	// load balancers.
+	Type    string
+	Version string
+	State   string
+	// Labels are the key/value labels set on the resource.
+	Labels map[string]string
+	// Nodes are the nodes of a managed database.
+	Nodes []NodeInfo
+}
+
+// NodeInfo is one node of a managed database as reported in node_states.
+type NodeInfo struct {
+	Name string
+	// Role is the role as reported by the API, e.g. master or standby.
+	Role string
+}
+
+// dbSystems maps UpCloud database types to db.system values.
+var dbSystems = map[string]string{
+	"pg":         "postgresql",
+	"mysql":      "mysql",
+	"opensearch": "opensearch",
+	"redis":      "redis",
+	"valkey":     "valkey",
+}
+
+// dbSystem returns the db.system value of an UpCloud database type. Unknown
+// types are passed through unchanged.
+func dbSystem(databaseType string) string {
+	if system, ok := dbSystems[databaseType]; ok {
+		return system
+	}
+	return databaseType
+}
+
+// zoneRegion returns the region of an UpCloud zone, which is the zone name
+// without its trailing number, e.g. fi-hel for fi-hel2.
+func zoneRegion(zone string) string {
+	return strings.TrimRight(zone, "0123456789")
+}
+
+// extractResources returns every resource of a discovery payload: either a
+// bare array or an object wrapping one (e.g. {"load_balancers": [...]}).
+func extractResources(payload any) []ResourceInfo {
+	switch root := payload.(type) {
+	case []any:
+		return extractResourcesFromArray(root)
+	case map[string]any:
+		var resources []ResourceInfo
+		if info, ok := parseResourceInfo(root); ok {
+			resources = append(resources, info)
+		}
+		for _, value := range root {
+			arr, ok := value.([]any)
+			if !ok {
+				continue
+			}
+			resources = append(resources, extractResourcesFromArray(arr)...)
+		}
+		return dedupeResources(resources)
+	default:
+		return nil
+	}
+}
+
+func extractResourcesFromArray(items []any) []ResourceInfo {
+	resources := make([]ResourceInfo, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		if info, ok := parseResourceInfo(obj); ok {
+			resources = append(resources, info)
+		}
+	}
+	return dedupeResources(resources)
+}
+
+// parseResourceInfo reads one resource object. Objects without a uuid are
+// not resources.
+func parseResourceInfo(obj map[string]any) (ResourceInfo, bool) {
+	uuid := stringField(obj, "uuid")
+	if uuid == "" {
+		return ResourceInfo{}, false
+	}
+	info := ResourceInfo{
+		UUID:    uuid,
+		Name:    firstNonEmpty(stringField(obj, "title"), stringField(obj, "name")),
+		Zone:    stringField(obj, "zone"),
+		Plan:    stringField(obj, "plan"),
+		Type:    stringField(obj, "type"),
+		Version: stringField(obj, "version"),
+		// Databases report state, load balancers operational_state.
+		State:  firstNonEmpty(stringField(obj, "state"), stringField(obj, "operational_state")),
+		Labels: parseLabels(obj["labels"]),
+		Nodes:  parseNodes(obj["node_states"]),
+	}
+	if properties, ok := obj["properties"].(map[string]any); ok {
+		info.Version = firstNonEmpty(stringField(properties, "version"), info.Version)
+	}
+	return info, true
+}
+
+// parseNodes reads the node_states list of a managed database.
+func parseNodes(value any) []NodeInfo {
+	items, ok := value.([]any)
+	if !ok {
+		return nil
+	}
+	var nodes []NodeInfo
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name := stringField(obj, "name"); name != "" {
+			nodes = append(nodes, NodeInfo{Name: name, Role: stringField(obj, "role")})
+		}
+	}
+	return nodes
+}
+
+func stringField(obj map[string]any, key string) string {
+	switch value := obj[key].(type) {
+	case string:
+		return strings.TrimSpace(value)
+	case float64:
+		return fmt.Sprintf("%g", value)
+	default:
+		return ""
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func dedupeResources(resources []ResourceInfo) []ResourceInfo {
+	seen := make(map[string]struct{}, len(resources))
+	out := make([]ResourceInfo, 0, len(resources))
+	for _, info := range resources {
+		if _, ok := seen[info.UUID]; ok {
+			continue
+		}
+		seen[info.UUID] = struct{}{}
+		out = append(out, info)
+	}
+	return out
+}
+
+func sortResources(resources []ResourceInfo) {
+	sort.Slice(resources, func(i, j int) bool { return resources[i].UUID < resources[j].UUID })
+}
+
+func resourceUUIDs(resources []ResourceInfo) []string {
+	uuids := make([]string, 0, len(resources))
+	for _, info := range resources {
+		uuids = append(uuids, info.UUID)
+	}
+	return uuids
+}
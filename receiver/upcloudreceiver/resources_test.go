@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestExtractResources(t *testing.T) {
+	payload := decodeJSON(t, `{
+		"load_balancers": [
+			{"uuid": "lb-1", "name": "edge", "zone": "de-fra1", "plan": "development", "operational_state": "running"},
+			{"uuid": "lb-1", "name": "duplicate"},
+			{"name": "no uuid"}
+		]
+	}`)
+
+	resources := extractResources(payload)
+	if len(resources) != 1 {
+		t.Fatalf("expected 1 resource, got %v", resources)
+	}
+	want := ResourceInfo{UUID: "lb-1", Name: "edge", Zone: "de-fra1", Plan: "development", State: "running"}
+	if !reflect.DeepEqual(resources[0], want) {
+		t.Fatalf("unexpected resource:\n got %+v\nwant %+v", resources[0], want)
+	}
+}
+
+func TestParseResourceInfo_Database(t *testing.T) {
+	obj := decodeJSON(t, `{
+		"uuid": "db-1",
+		"title": "payments",
+		"name": "payments-db",
+		"zone": "fi-hel2",
+		"plan": "2x2xCPU-4GB-100GB",
+		"type": "pg",
+		"state": "running",
+		"version": "15",
+		"properties": {"version": "16"},
+		"labels": [{"key": "team", "value": "payments"}, {"key": "env", "value": "prod"}],
+		"node_states": [{"name": "payments-1", "role": "master", "state": "running"}, {"role": "standby"}]
+	}`).(map[string]any)
+
+	info, ok := parseResourceInfo(obj)
+	if !ok {
+		t.Fatalf("expected a resource")
+	}
+	want := ResourceInfo{UUID: "db-1", Name: "payments", Zone: "fi-hel2", Plan: "2x2xCPU-4GB-100GB", Type: "pg", Version: "16", State: "running",
+		Labels: map[string]string{"team": "payments", "env": "prod"},
+		Nodes:  []NodeInfo{{Name: "payments-1", Role: "master"}},
+	}
+	if !reflect.DeepEqual(info, want) {
+		t.Fatalf("unexpected resource:\n got %+v\nwant %+v", info, want)
+	}
+}
+
+func TestZoneRegion(t *testing.T) {
+	for zone, want := range map[string]string{
+		"fi-hel2":  "fi-hel",
+		"de-fra1":  "de-fra",
+		"us-nyc1":  "us-nyc",
+		"sg-sin1":  "sg-sin",
+		"no-digit": "no-digit",
+	} {
+		if got := zoneRegion(zone); got != want {
+			t.Errorf("zoneRegion(%q) = %q, want %q", zone, got, want)
+		}
+	}
+}
+
+func TestDBSystem(t *testing.T) {
+	for databaseType, want := range map[string]string{
+		"pg":         "postgresql",
+		"mysql":      "mysql",
+		"opensearch": "opensearch",
+		"valkey":     "valkey",
+		"future":     "future",
+	} {
+		if got := dbSystem(databaseType); got != want {
+			t.Errorf("dbSystem(%q) = %q, want %q", databaseType, got, want)
+		}
+	}
+}
+
+func decodeJSON(t *testing.T, raw string) any {
+	t.Helper()
+	var payload any
+	if err := json.Unmarshal([]byte(raw), &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	return payload
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// transientError marks a transport failure as safe to retry.
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transientError) Unwrap() error {
+	return e.err
+}
+
+// classifyRetry reports whether err is transient and how long the server asked
+// the client to wait before retrying.
+func classifyRetry(err error) (bool, time.Duration) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Retryable(), apiErr.retryAfter
+	}
+	var transient *transientError
+	return errors.As(err, &transient), 0
+}
+
+func unwrapTransient(err error) error {
+	var transient *transientError
+	if errors.As(err, &transient) {
+		return transient.err
+	}
+	return err
+}
+
+func isRetryableTransportError(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// parseRetryAfter decodes a Retry-After header given either as delay seconds
+// or as an HTTP date. Unparseable or past values yield zero.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if delay := at.Sub(now); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
+
+// backoff returns the delay before the next attempt. attempt is the number of
+// attempts already made. A server-provided Retry-After takes precedence when
+// it asks for a longer wait than the computed backoff.
+func (cfg RetryConfig) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	delay := cfg.InitialBackoff
+	for i := 1; i < attempt && delay > 0; i++ {
+		delay *= 2
+		if cfg.MaxBackoff > 0 && delay >= cfg.MaxBackoff {
+			break
+		}
+	}
+	if cfg.MaxBackoff > 0 && delay > cfg.MaxBackoff {
+		delay = cfg.MaxBackoff
+	}
+	if cfg.Jitter > 0 && delay > 0 {
+		spread := float64(delay) * cfg.Jitter
+		delay += time.Duration(spread * (2*rand.Float64() - 1))
+	}
+	if retryAfter > delay {
+		delay = retryAfter
+	}
+	return max(delay, 0)
+}
+
+// waitContext sleeps for delay unless ctx is cancelled first. It fails fast
+// when the context deadline would expire before the delay elapses, so a retry
+// or a scheduled fetch never outlives the scrape that triggered it.
+func waitContext(ctx context.Context, delay time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return context.DeadlineExceeded
+	}
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+const (
+	scheduleModeBurst  = "burst"
+	scheduleModeSpread = "spread"
+)
+
+// spreadSchedule delays the fetch of each resource by a stable offset within
+// the collection interval, so the requests of a scrape are spread evenly
+// instead of all hitting the API when the scrape starts. The offset is derived
+// from the resource UUID and only moves by the configured jitter.
+type spreadSchedule struct {
+	window time.Duration
+	jitter time.Duration
+	random func() float64
+}
+
+// newSpreadSchedule returns nil, which fetches every resource immediately,
+// unless schedule.mode is spread.
+func newSpreadSchedule(cfg *Config) *spreadSchedule {
+	if !strings.EqualFold(strings.TrimSpace(cfg.Schedule.Mode), scheduleModeSpread) {
+		return nil
+	}
+	window := spreadWindow(cfg)
+	if window <= 0 {
+		return nil
+	}
+	return &spreadSchedule{
+		window: window,
+		jitter: cfg.Schedule.Jitter,
+		random: rand.Float64,
+	}
+}
+
+// spreadWindow is the part of the interval in which fetches may start: the
+// last one still needs api.timeout to finish before the next scrape (or the
+// scrape deadline) is due.
+func spreadWindow(cfg *Config) time.Duration {
+	window := cfg.CollectionInterval
+	if cfg.Timeout > 0 {
+		window = min(window, cfg.Timeout)
+	}
+	return window - cfg.API.Timeout
+}
+
+// delays returns the offset from the start of the scrape at which each
+// target is fetched. A nil schedule fetches every target immediately.
+func (s *spreadSchedule) delays(targets []scrapeTarget) []time.Duration {
+	delays := make([]time.Duration, len(targets))
+	if s == nil {
+		return delays
+	}
+	for idx, target := range targets {
+		delays[idx] = s.delay(target)
+	}
+	return delays
+}
+
+func (s *spreadSchedule) delay(target scrapeTarget) time.Duration {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(resourceKey(target.resourceType, target.uuid)))
+	offset := time.Duration(hash.Sum64() % uint64(s.window))
+	if s.jitter > 0 {
+		offset += time.Duration(s.random() * float64(s.jitter))
+	}
+	return offset % s.window
+}
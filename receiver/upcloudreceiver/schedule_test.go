@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+func spreadConfig(interval, apiTimeout, jitter time.Duration) *Config {
+	return &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ControllerConfig:     scraperhelper.ControllerConfig{CollectionInterval: interval},
+		API:                  APIConfig{ClientConfig: confighttp.ClientConfig{Timeout: apiTimeout}},
+		Schedule:             ScheduleConfig{Mode: scheduleModeSpread, Jitter: jitter},
+	}
+}
+
+func TestNewSpreadSchedule_BurstByDefault(t *testing.T) {
+	cfg := spreadConfig(time.Minute, 10*time.Second, 0)
+	cfg.Schedule.Mode = scheduleModeBurst
+	if s := newSpreadSchedule(cfg); s != nil {
+		t.Fatalf("expected no schedule in burst mode, got %+v", s)
+	}
+	var none *spreadSchedule
+	if got := none.delays(make([]scrapeTarget, 3)); len(got) != 3 || got[0] != 0 || got[1] != 0 || got[2] != 0 {
+		t.Fatalf("expected zero delays without a schedule, got %v", got)
+	}
+}
+
+func TestSpreadSchedule_StableOffsetsWithinWindow(t *testing.T) {
+	cfg := spreadConfig(time.Minute, 10*time.Second, 0)
+	cfg.Timeout = 40 * time.Second
+	schedule := newSpreadSchedule(cfg)
+	if schedule.window != 30*time.Second {
+		t.Fatalf("expected the window to end api.timeout before the scrape deadline, got %s", schedule.window)
+	}
+
+	targets := appendScrapeTargets(nil, resourceTypeManagedDatabase, []string{"db-1", "db-2", "db-3", "db-4"})
+	first := schedule.delays(targets)
+	second := schedule.delays(targets)
+	distinct := make(map[time.Duration]struct{})
+	for idx := range targets {
+		if first[idx] != second[idx] {
+			t.Fatalf("expected a stable offset for %s, got %s and %s", targets[idx].uuid, first[idx], second[idx])
+		}
+		if first[idx] < 0 || first[idx] >= schedule.window {
+			t.Fatalf("offset %s of %s is outside the window", first[idx], targets[idx].uuid)
+		}
+		distinct[first[idx]] = struct{}{}
+	}
+	if len(distinct) < 2 {
+		t.Fatalf("expected offsets to differ between resources, got %v", first)
+	}
+
+	lb := schedule.delays(appendScrapeTargets(nil, resourceTypeManagedLoadBalancer, []string{"db-1"}))
+	if lb[0] == first[0] {
+		t.Fatalf("expected the resource type to be part of the offset")
+	}
+}
+
+func TestSpreadSchedule_JitterWrapsAroundWindow(t *testing.T) {
+	schedule := newSpreadSchedule(spreadConfig(time.Minute, 10*time.Second, 20*time.Second))
+	target := scrapeTarget{resourceType: resourceTypeManagedDatabase, uuid: "db-1"}
+
+	schedule.random = func() float64 { return 0 }
+	base := schedule.delay(target)
+	schedule.random = func() float64 { return 0.5 }
+	jittered := schedule.delay(target)
+
+	if want := (base + 10*time.Second) % schedule.window; jittered != want {
+		t.Fatalf("expected jittered offset %s, got %s", want, jittered)
+	}
+}
+
+// timingClient records when each resource was fetched.
+type timingClient struct {
+	fakeClient
+	mu    sync.Mutex
+	calls map[string]time.Time
+}
+
+func (c *timingClient) GetManagedDatabaseMetrics(_ context.Context, uuid string, _ string) (MetricsResponse, error) {
+	c.mu.Lock()
+	c.calls[uuid] = time.Now()
+	c.mu.Unlock()
+	return MetricsResponse{
+		"cpu_usage": {
+			Data: MetricsData{
+				Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}},
+				Rows: [][]any{{"2026-02-21T08:00:00Z", 10.0}},
+			},
+		},
+	}, nil
+}
+
+func TestScrapeMetrics_SpreadScheduleDelaysFetches(t *testing.T) {
+	uuids := make([]string, 0, 8)
+	for i := 0; i < 8; i++ {
+		uuids = append(uuids, fmt.Sprintf("db-%02d", i))
+	}
+	cfg := spreadConfig(300*time.Millisecond, 100*time.Millisecond, 0)
+	cfg.MaxConcurrency = 2
+	cfg.ManagedDatabases = ManagedDatabaseConfig{Enabled: true, UUIDs: uuids}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+	client := &timingClient{calls: make(map[string]time.Time)}
+
+	before := time.Now()
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop())
+	if err != nil {
+		t.Fatalf("unexpected scrape error: %v", err)
+	}
+
+	delays := state.schedule.delays(appendScrapeTargets(nil, resourceTypeManagedDatabase, uuids))
+	for idx, uuid := range uuids {
+		called, ok := client.calls[uuid]
+		if !ok {
+			t.Fatalf("expected %s to be fetched", uuid)
+		}
+		if earliest := before.Add(delays[idx]); called.Before(earliest) {
+			t.Fatalf("%s was fetched %s before its offset", uuid, earliest.Sub(called))
+		}
+	}
+	// Metrics keep the target order regardless of the fetch order.
+	for i, want := range uuids {
+		got := metrics.ResourceMetrics().At(i).Resource().Attributes().AsRaw()["upcloud.resource.uuid"]
+		if got != want {
+			t.Fatalf("resource %d: expected %s, got %v", i, want, got)
+		}
+	}
+}
+
+func TestScrapeMetrics_SpreadScheduleStopsAtDeadline(t *testing.T) {
+	cfg := spreadConfig(time.Hour, time.Second, 0)
+	cfg.ManagedDatabases = ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-1", "db-2", "db-3"}}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+	client := &timingClient{calls: make(map[string]time.Time)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	_, err := scrapeMetrics(ctx, client, cfg, state, zap.NewNop())
+	if err == nil {
+		t.Fatalf("expected the fetches beyond the deadline to fail")
+	}
+	if elapsed := time.Since(started); elapsed > 5*time.Second {
+		t.Fatalf("expected the scrape to give up at the deadline, took %s", elapsed)
+	}
+}
+
+func TestScrapeAccounts_SpreadAccountsFinishWithinInterval(t *testing.T) {
+	const interval = 300 * time.Millisecond
+	settings := receivertest.NewNopSettings(metadata.Type)
+	var accounts []accountScraper
+	for _, name := range []string{"dev", "prod"} {
+		uuids := make([]string, 0, 8)
+		for i := 0; i < 8; i++ {
+			uuids = append(uuids, fmt.Sprintf("%s-db-%02d", name, i))
+		}
+		cfg := spreadConfig(interval, 100*time.Millisecond, 0)
+		cfg.ManagedDatabases = ManagedDatabaseConfig{Enabled: true, UUIDs: uuids}
+		accounts = append(accounts, newAccountScraper(name, cfg, &timingClient{calls: make(map[string]time.Time)}, settings))
+	}
+
+	started := time.Now()
+	metrics, err := scrapeAccounts(context.Background(), accounts, zap.NewNop())
+	if err != nil {
+		t.Fatalf("unexpected scrape error: %v", err)
+	}
+	if elapsed := time.Since(started); elapsed >= interval {
+		t.Fatalf("expected both accounts to be scraped within one interval, took %s", elapsed)
+	}
+	if got := metrics.ResourceMetrics().Len(); got != 16 {
+		t.Fatalf("expected 16 resources, got %d", got)
+	}
+	// Accounts keep their order regardless of which one finished first.
+	if account, _ := metrics.ResourceMetrics().At(0).Resource().Attributes().Get(accountAttribute); account.Str() != "dev" {
+		t.Fatalf("expected the first account first, got %q", account.Str())
+	}
+}
@@ -11,141 +11,539 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
 	"go.uber.org/zap"
-)
 
-const instrumentationScopeName = "github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver"
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
 
 const (
 	resourceTypeManagedDatabase     = "managed_database"
 	resourceTypeManagedLoadBalancer = "managed_load_balancer"
 )
 
-func scrapeMetrics(ctx context.Context, client Client, cfg *Config, logger *zap.Logger) (pmetric.Metrics, error) {
+// scrapeState is what one account remembers between scrapes, together with
+// the MetricsBuilder its metrics are recorded with. Create it with
+// newScrapeState.
+type scrapeState struct {
+	// checkpoints is only set when backfill is enabled.
+	checkpoints *checkpoints
+	periods     *periodSelector
+	stale       *staleTracker
+	discovery   *discoveryCache
+	// schedule is only set when schedule.mode is spread.
+	schedule *spreadSchedule
+	metrics  *metadata.MetricsBuilder
+	counters *counterTracker
+}
+
+func newScrapeState(cfg *Config, settings receiver.Settings) *scrapeState {
+	state := &scrapeState{
+		metrics:   metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		periods:   newPeriodSelector(cfg.CollectionInterval),
+		stale:     newStaleTracker(),
+		discovery: newDiscoveryCache(cfg.DiscoveryInterval, cfg.DetailsRefreshInterval),
+		schedule:  newSpreadSchedule(cfg),
+		counters:  newCounterTracker(),
+	}
+	if cfg.Backfill {
+		state.checkpoints = newCheckpoints()
+	}
+	return state
+}
+
+// commit makes the progress of a consumed batch permanent: checkpoints
+// advance and vanished resources are forgotten. It reports whether the
+// checkpoints changed.
+func (s *scrapeState) commit() bool {
+	s.stale.commit()
+	s.periods.commit()
+	s.discovery.commit()
+	s.counters.commit()
+	if s.checkpoints == nil {
+		return false
+	}
+	return s.checkpoints.commit()
+}
+
+// discard drops the progress of a batch that was not consumed, so its rows
+// and staleness markers are emitted again by the next scrape.
+func (s *scrapeState) discard() {
+	s.stale.discard()
+	s.periods.discard()
+	s.discovery.discard()
+	s.counters.discard()
+	if s.checkpoints != nil {
+		s.checkpoints.discard()
+	}
+}
+
+// scrapeMetrics scrapes every enabled resource block of one account with the
+// state made by newScrapeState. Without checkpoints only the latest row of each metric is emitted; otherwise every
+// row newer than the series checkpoint is emitted (backfill).
+func scrapeMetrics(ctx context.Context, client Client, cfg *Config, state *scrapeState, logger *zap.Logger) (pmetric.Metrics, error) {
 	out := pmetric.NewMetrics()
-	var errs []error
+	var errs scrapererror.ScrapeErrors
+	start := time.Now()
 
+	var targets []scrapeTarget
 	if cfg.ManagedDatabases.Enabled {
-		targetUUIDs, err := resolveManagedDatabaseUUIDs(ctx, client, cfg.ManagedDatabases)
+		targetUUIDs, err := resolveManagedDatabaseUUIDs(ctx, client, cfg.ManagedDatabases, state.discovery)
 		if err != nil {
-			errs = append(errs, err)
-		}
-		for _, uuid := range targetUUIDs {
-			resp, err := client.GetManagedDatabaseMetrics(ctx, uuid, cfg.ManagedDatabases.Period)
-			if err != nil {
-				errs = append(errs, fmt.Errorf("managed database %s: %w", uuid, err))
-				continue
+			logger.Warn("UpCloud discovery failed", zap.String("resource_type", resourceTypeManagedDatabase), zap.Error(err))
+			// Failed only counts resources; a failed discovery is no resource.
+			errs.AddPartial(0, err)
+			if isFatalScrapeError(err) {
+				return out, errs.Combine()
 			}
-			appendMetricsPayload(out, resp, resourceTypeManagedDatabase, uuid, cfg.ManagedDatabases.Metrics, logger)
+		} else {
+			state.markVanished(out, resourceTypeManagedDatabase, targetUUIDs, logger)
 		}
+		targets = appendScrapeTargets(targets, resourceTypeManagedDatabase, targetUUIDs)
 	}
 
 	if cfg.ManagedLoadBalancers.Enabled {
-		targetUUIDs, err := resolveManagedLoadBalancerUUIDs(ctx, client, cfg.ManagedLoadBalancers)
+		targetUUIDs, err := resolveManagedLoadBalancerUUIDs(ctx, client, cfg.ManagedLoadBalancers, state.discovery)
 		if err != nil {
-			errs = append(errs, err)
+			logger.Warn("UpCloud discovery failed", zap.String("resource_type", resourceTypeManagedLoadBalancer), zap.Error(err))
+			errs.AddPartial(0, err)
+			if isFatalScrapeError(err) {
+				return out, errs.Combine()
+			}
+		} else {
+			state.markVanished(out, resourceTypeManagedLoadBalancer, targetUUIDs, logger)
 		}
-		for _, uuid := range targetUUIDs {
-			resp, err := client.GetManagedLoadBalancerMetrics(ctx, uuid, cfg.ManagedLoadBalancers.Period)
-			if err != nil {
-				errs = append(errs, fmt.Errorf("managed load balancer %s: %w", uuid, err))
-				continue
+		targets = appendScrapeTargets(targets, resourceTypeManagedLoadBalancer, targetUUIDs)
+	}
+
+	// Both blocks are fetched in one pass so that a spread schedule covers
+	// every target of the account within the same interval.
+	delays := state.schedule.delays(targets)
+	results := fetchResourceMetrics(ctx, targets, start, delays, cfg.MaxConcurrency, func(ctx context.Context, target scrapeTarget) (MetricsResponse, error) {
+		switch target.resourceType {
+		case resourceTypeManagedLoadBalancer:
+			state.describe(target, func() (ResourceInfo, error) {
+				return client.GetManagedLoadBalancer(ctx, detailsPath(cfg.ManagedLoadBalancers.DiscoveryPath, defaultManagedLoadBalancerDiscovery), target.uuid)
+			}, logger)
+			period := state.periods.resolve(cfg.ManagedLoadBalancers.Period, target.resourceType, target.uuid, state.checkpoints)
+			return client.GetManagedLoadBalancerMetrics(ctx, target.uuid, period)
+		default:
+			state.describe(target, func() (ResourceInfo, error) {
+				return client.GetManagedDatabase(ctx, detailsPath(cfg.ManagedDatabases.DiscoveryPath, defaultManagedDatabaseDiscovery), target.uuid)
+			}, logger)
+			period := state.periods.resolve(cfg.ManagedDatabases.Period, target.resourceType, target.uuid, state.checkpoints)
+			return client.GetManagedDatabaseMetrics(ctx, target.uuid, period)
+		}
+	})
+	appendResourceResults(out, results, cfg, state, &errs, logger)
+
+	return out, errs.Combine()
+}
+
+// scrapeTarget is one resource fetched by a scrape.
+type scrapeTarget struct {
+	resourceType string
+	uuid         string
+}
+
+// describe makes sure the discovery cache knows the details of target, so
+// its resource can be enriched. Discovered resources are already known;
+// explicitly configured ones are looked up. A failed lookup only costs the
+// enrichment, not the metrics.
+func (s *scrapeState) describe(target scrapeTarget, fetch func() (ResourceInfo, error), logger *zap.Logger) {
+	if s.discovery == nil {
+		return
+	}
+	if _, err := s.discovery.lookupDetails(target.resourceType, target.uuid, fetch); err != nil {
+		logger.Debug("UpCloud resource details lookup failed",
+			zap.String("resource_type", target.resourceType),
+			zap.String("uuid", target.uuid),
+			zap.Error(err),
+		)
+	}
+}
+
+// detailsPath returns the collection path that resource details are read
+// from: <discovery_path>/<uuid>.
+func detailsPath(discoveryPath string, fallback string) string {
+	if strings.TrimSpace(discoveryPath) == "" {
+		return fallback
+	}
+	return discoveryPath
+}
+
+func appendScrapeTargets(targets []scrapeTarget, resourceType string, uuids []string) []scrapeTarget {
+	for _, uuid := range uuids {
+		targets = append(targets, scrapeTarget{resourceType: resourceType, uuid: uuid})
+	}
+	return targets
+}
+
+// markVanished ends the series of every resource that was a target in the
+// previous scrape but is not anymore, and forgets the per-resource state.
+// The state is only dropped when the batch is committed, so a rejected batch
+// is emitted again with the same periods, details and checkpoints.
+func (s *scrapeState) markVanished(out pmetric.Metrics, resourceType string, targets []string, logger *zap.Logger) {
+	for _, uuid := range s.stale.markVanished(out, resourceType, targets) {
+		s.periods.forget(resourceType, uuid)
+		s.checkpoints.forget(resourceType, uuid)
+		s.discovery.forget(resourceType, uuid)
+		s.counters.forget(resourceType, uuid)
+		logger.Info("UpCloud resource disappeared, marking its series stale",
+			zap.String("resource_type", resourceType),
+			zap.String("uuid", uuid),
+		)
+	}
+}
+
+// appendResourceResults appends every successful result to out and records
+// each failed resource as a partial scrape error.
+func appendResourceResults(
+	out pmetric.Metrics,
+	results []resourceMetricsResult,
+	cfg *Config,
+	state *scrapeState,
+	errs *scrapererror.ScrapeErrors,
+	logger *zap.Logger,
+) {
+	for _, result := range results {
+		resourceType, uuid := result.target.resourceType, result.target.uuid
+		if result.err == nil {
+			state.periods.observe(resourceType, uuid)
+			info := state.discovery.info(resourceType, uuid)
+			if rm, ok := appendMetricsPayload(out, result.resp, resourceType, info, cfg.payloadOptions(resourceType), state.checkpoints, state.metrics, logger); ok {
+				state.counters.adjust(resourceType, uuid, rm, cfg.CounterRates)
+				state.stale.remember(resourceType, uuid, rm)
 			}
-			appendMetricsPayload(out, resp, resourceTypeManagedLoadBalancer, uuid, cfg.ManagedLoadBalancers.Metrics, logger)
+			continue
+		}
+
+		fields := []zap.Field{
+			zap.String("resource_type", resourceType),
+			zap.String("uuid", uuid),
+			zap.Error(result.err),
+		}
+		var apiErr *APIError
+		if errors.As(result.err, &apiErr) {
+			fields = append(fields, zap.String("error_kind", string(apiErr.Kind())))
+		}
+		logger.Warn("Failed to scrape UpCloud resource", fields...)
+
+		// A discovered resource that is gone must not stay in the cached target
+		// set. A configured UUID stays a target, so re-listing would not help.
+		if apiErr != nil && apiErr.Kind() == APIErrorKindNotFound && state.discovery.discovered(resourceType, uuid) {
+			state.discovery.invalidate(resourceType)
 		}
+
+		errs.AddPartial(1, fmt.Errorf("%s %s: %w", strings.ReplaceAll(resourceType, "_", " "), uuid, result.err))
+	}
+}
+
+// payloadOptions are the settings of a resource block that shape its metrics.
+type payloadOptions struct {
+	allowlist       []string
+	overrides       map[string]MetricOverrideConfig
+	labels          LabelsConfig
+	keepSeriesLabel bool
+}
+
+func (cfg *Config) payloadOptions(resourceType string) payloadOptions {
+	opts := payloadOptions{
+		allowlist:       cfg.ManagedDatabases.Metrics,
+		overrides:       cfg.ManagedDatabases.MetricOverrides,
+		labels:          cfg.Labels,
+		keepSeriesLabel: cfg.KeepSeriesLabel,
+	}
+	if resourceType == resourceTypeManagedLoadBalancer {
+		opts.allowlist = cfg.ManagedLoadBalancers.Metrics
+		opts.overrides = cfg.ManagedLoadBalancers.MetricOverrides
+	}
+	return opts
+}
+
+type resourceMetricsResult struct {
+	target scrapeTarget
+	resp   MetricsResponse
+	err    error
+}
+
+// fetchResourceMetrics calls fetch for every target using at most
+// maxConcurrency concurrent requests. Each target is started no earlier than
+// its delay after start, in the order of the delays. Results are returned in
+// the order of targets so the emitted metrics do not depend on request
+// timing. A fatal error cancels the requests that have not started yet.
+func fetchResourceMetrics(
+	ctx context.Context,
+	targets []scrapeTarget,
+	start time.Time,
+	delays []time.Duration,
+	maxConcurrency int,
+	fetch func(ctx context.Context, target scrapeTarget) (MetricsResponse, error),
+) []resourceMetricsResult {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]resourceMetricsResult, len(targets))
+	order := make([]int, len(targets))
+	for idx, target := range targets {
+		results[idx].target = target
+		order[idx] = idx
 	}
+	sort.SliceStable(order, func(i, j int) bool { return delays[order[i]] < delays[order[j]] })
 
-	if len(errs) > 0 {
-		return out, errors.Join(errs...)
+	sem := make(chan struct{}, max(maxConcurrency, 1))
+	var wg sync.WaitGroup
+	for _, idx := range order {
+		if err := waitContext(ctx, time.Until(start.Add(delays[idx]))); err != nil {
+			results[idx].err = err
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			results[idx].err = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+		if err := ctx.Err(); err != nil {
+			<-sem
+			results[idx].err = err
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			resp, err := fetch(ctx, targets[idx])
+			results[idx].resp = resp
+			results[idx].err = err
+			if isFatalScrapeError(err) {
+				cancel()
+			}
+		}()
 	}
-	return out, nil
+	wg.Wait()
+	return results
+}
+
+// isFatalScrapeError reports errors that every remaining request in the scrape
+// would hit as well, such as rejected credentials, so the scrape stops early.
+func isFatalScrapeError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Kind() == APIErrorKindUnauthorized
 }
 
 func appendMetricsPayload(
 	out pmetric.Metrics,
 	payload MetricsResponse,
 	resourceType string,
-	resourceUUID string,
-	allowlist []string,
+	info ResourceInfo,
+	opts payloadOptions,
+	cp *checkpoints,
+	mb *metadata.MetricsBuilder,
 	logger *zap.Logger,
-) {
-	allowed := toAllowlist(allowlist)
-
-	rm := out.ResourceMetrics().AppendEmpty()
-	rm.Resource().Attributes().PutStr("cloud.provider", "upcloud")
-	rm.Resource().Attributes().PutStr("upcloud.resource.type", resourceType)
-	rm.Resource().Attributes().PutStr("upcloud.resource.uuid", resourceUUID)
-
-	sm := rm.ScopeMetrics().AppendEmpty()
-	sm.Scope().SetName(instrumentationScopeName)
-	metrics := sm.Metrics()
+) (pmetric.ResourceMetrics, bool) {
+	allowed := toAllowlist(opts.allowlist)
 
+	// Known metrics are buffered in the builder, unknown keys in fallback.
+	fallback := pmetric.NewMetricSlice()
 	for metricKey, metric := range payload {
 		if len(allowed) > 0 {
 			if _, ok := allowed[metricKey]; !ok {
 				continue
 			}
 		}
-		appendMetric(metricKey, metric, resourceType, metrics, logger)
+		appendMetric(metricKey, metric, resourceType, info, opts, cp, mb, fallback, logger)
 	}
+
+	res := buildResource(mb.NewResourceBuilder(), resourceType, info, opts.labels)
+
+	emitted := mb.Emit(metadata.WithResource(res))
+	var rm pmetric.ResourceMetrics
+	if emitted.ResourceMetrics().Len() > 0 {
+		rm = emitted.ResourceMetrics().At(0)
+	} else {
+		// Nothing known was recorded, so the builder emitted no resource.
+		rm = emitted.ResourceMetrics().AppendEmpty()
+		res.CopyTo(rm.Resource())
+		rm.ScopeMetrics().AppendEmpty().Scope().SetName(metadata.ScopeName)
+	}
+	fallback.MoveAndAppendTo(rm.ScopeMetrics().At(0).Metrics())
+
+	// In backfill mode a resource without new rows has nothing to report.
+	if cp != nil && rm.ScopeMetrics().At(0).Metrics().Len() == 0 {
+		return pmetric.ResourceMetrics{}, false
+	}
+	dest := out.ResourceMetrics().AppendEmpty()
+	rm.MoveTo(dest)
+	return dest, true
 }
 
-func appendMetric(metricKey string, metric MetricsItem, resourceType string, dest pmetric.MetricSlice, logger *zap.Logger) {
+// buildResource sets the resource attributes of a scraped resource. Details
+// the API did not report are left out.
+func buildResource(rb *metadata.ResourceBuilder, resourceType string, info ResourceInfo, labels LabelsConfig) pcommon.Resource {
+	rb.SetCloudProvider("upcloud")
+	rb.SetUpcloudResourceType(resourceType)
+	rb.SetUpcloudResourceUUID(info.UUID)
+	if info.Name != "" {
+		rb.SetUpcloudResourceName(info.Name)
+	}
+	if info.Zone != "" {
+		rb.SetCloudRegion(zoneRegion(info.Zone))
+		rb.SetCloudAvailabilityZone(info.Zone)
+	}
+	if info.Plan != "" {
+		rb.SetUpcloudResourcePlan(info.Plan)
+	}
+	if info.State != "" {
+		rb.SetUpcloudResourceState(info.State)
+	}
+	if resourceType == resourceTypeManagedDatabase {
+		if info.Type != "" {
+			rb.SetUpcloudDatabaseType(info.Type)
+			rb.SetDbSystem(dbSystem(info.Type))
+		}
+		if info.Version != "" {
+			rb.SetUpcloudDatabaseVersion(info.Version)
+		}
+	}
+	res := rb.Emit()
+	putLabels(res.Attributes(), info.Labels, labels)
+	return res
+}
+
+// appendMetric records the rows of one metric key. Known metrics go to mb;
+// overridden and unknown keys are appended to dest.
+func appendMetric(
+	metricKey string,
+	metric MetricsItem,
+	resourceType string,
+	info ResourceInfo,
+	opts payloadOptions,
+	cp *checkpoints,
+	mb *metadata.MetricsBuilder,
+	dest pmetric.MetricSlice,
+	logger *zap.Logger,
+) {
 	if len(metric.Data.Cols) < 2 || len(metric.Data.Rows) == 0 {
 		return
 	}
 
-	row := metric.Data.Rows[len(metric.Data.Rows)-1]
-	if len(row) < 2 {
-		return
+	rows := metric.Data.Rows
+	if cp == nil {
+		rows = rows[len(rows)-1:]
 	}
+	descriptor := descriptorForMetric(resourceType, info.Type, metricKey, metric.Hints, opts.overrides)
 
-	timestamp := extractTime(row[0])
-	descriptor := descriptorForMetric(resourceType, metricKey)
+	var m pmetric.Metric
+	var dps pmetric.NumberDataPointSlice
+	record := func(ts pcommon.Timestamp, value float64, attrs seriesAttributes) {
+		descriptor.record(mb, ts, value, metricKey, attrs)
+	}
+	if descriptor.record == nil {
+		m = pmetric.NewMetric()
+		m.SetName(descriptor.Name)
+		m.SetDescription(descriptor.Description)
+		if descriptor.Description == "" {
+			m.SetDescription(metric.Hints.Title)
+		}
+		m.SetUnit(descriptor.Unit)
+		if descriptor.Instrument == instrumentSum {
+			sum := m.SetEmptySum()
+			sum.SetIsMonotonic(true)
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+			dps = sum.DataPoints()
+		} else {
+			dps = m.SetEmptyGauge().DataPoints()
+		}
+		record = func(ts pcommon.Timestamp, value float64, attrs seriesAttributes) {
+			dp := dps.AppendEmpty()
+			dp.SetTimestamp(ts)
+			dp.SetDoubleValue(value)
+			dp.Attributes().PutStr("upcloud.metric.name", metricKey)
+			attrs.putTo(dp.Attributes())
+			if descriptor.PercentToRatio {
+				dp.Attributes().PutStr("upcloud.value.normalization", "percent_to_ratio")
+			}
+		}
+	}
 
-	m := dest.AppendEmpty()
-	m.SetName(descriptor.Name)
-	m.SetDescription(metric.Hints.Title)
-	m.SetUnit(descriptor.Unit)
-	m.SetEmptyGauge()
-	g := m.Gauge().DataPoints()
+	for idx := 1; idx < len(metric.Data.Cols); idx++ {
+		series := metric.Data.Cols[idx].Label
+		key := seriesKey{ResourceType: resourceType, ResourceUUID: info.UUID, Metric: metricKey, Series: series}
+		attrs := newSeriesAttributes(series, resourceType, info.Nodes, opts.keepSeriesLabel)
+		var since, newest time.Time
+		if cp != nil {
+			since = cp.since(key)
+			newest = since
+		}
 
-	for idx := 1; idx < len(metric.Data.Cols) && idx < len(row); idx++ {
-		value, ok := toFloat64(row[idx])
-		if !ok {
-			logger.Debug("Skipping non-numeric metric value",
-				zap.String("metric", metricKey),
-				zap.Int("column", idx),
-			)
-			continue
+		for _, row := range rows {
+			if len(row) < 2 || idx >= len(row) {
+				continue
+			}
+
+			var timestamp time.Time
+			if cp == nil {
+				timestamp = extractTime(row[0])
+			} else {
+				// Rows without a usable time cannot be de-duplicated, so backfill skips them.
+				parsed, ok := parseRowTime(row[0])
+				if !ok || !parsed.After(since) {
+					continue
+				}
+				timestamp = parsed
+			}
+
+			value, ok := toFloat64(row[idx])
+			if !ok {
+				logger.Debug("Skipping non-numeric metric value",
+					zap.String("metric", metricKey),
+					zap.Int("column", idx),
+				)
+				continue
+			}
+			record(pcommon.NewTimestampFromTime(timestamp), descriptor.normalizeValue(value), attrs)
+			if timestamp.After(newest) {
+				newest = timestamp
+			}
 		}
-		value = descriptor.normalizeValue(value)
 
-		dp := g.AppendEmpty()
-		dp.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
-		dp.SetDoubleValue(value)
-		dp.Attributes().PutStr("upcloud.metric.name", metricKey)
-		dp.Attributes().PutStr("upcloud.series", metric.Data.Cols[idx].Label)
-		if descriptor.PercentToRatio {
-			dp.Attributes().PutStr("upcloud.value.normalization", "percent_to_ratio")
+		if cp != nil {
+			cp.advance(key, newest)
 		}
 	}
+
+	if descriptor.record == nil && dps.Len() > 0 {
+		m.MoveTo(dest.AppendEmpty())
+	}
 }
 
 func extractTime(v any) time.Time {
-	s, ok := v.(string)
+	parsed, ok := parseRowTime(v)
 	if !ok {
 		return nowTimestamp(time.Time{})
 	}
+	return parsed
+}
+
+// parseRowTime parses the RFC 3339 time column of a metrics row.
+func parseRowTime(v any) (time.Time, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, false
+	}
 	parsed, err := time.Parse(time.RFC3339, s)
 	if err != nil {
-		return nowTimestamp(time.Time{})
+		return time.Time{}, false
 	}
-	return parsed.UTC()
+	return parsed.UTC(), true
 }
 
 func toAllowlist(values []string) map[string]struct{} {
@@ -196,26 +594,43 @@ func toFloat64(v any) (float64, bool) {
 	}
 }
 
-func resolveManagedDatabaseUUIDs(ctx context.Context, client Client, cfg ManagedDatabaseConfig) ([]string, error) {
+// resolveManagedDatabaseUUIDs returns the explicit database UUIDs and the
+// discovered ones matching the selector, minus the excluded ones. When discovery fails the last good
+// discovered set is still included, together with the error.
+func resolveManagedDatabaseUUIDs(ctx context.Context, client Client, cfg ManagedDatabaseConfig, cache *discoveryCache) ([]string, error) {
 	targets := append([]string(nil), cfg.UUIDs...)
 	if cfg.AutoDiscover {
-		discovered, err := client.ListManagedDatabaseServiceUUIDs(ctx, cfg.DiscoveryPath, cfg.DiscoveryLimit)
+		discovered, err := cache.lookup(resourceTypeManagedDatabase, func() ([]ResourceInfo, error) {
+			return client.ListManagedDatabases(ctx, cfg.DiscoveryPath, cfg.DiscoveryLimit)
+		})
+		selected, selectErr := selectResources(discovered, cfg.Selector)
+		if selectErr != nil {
+			return nil, fmt.Errorf("managed_databases.selector: %w", selectErr)
+		}
+		targets = append(targets, resourceUUIDs(selected)...)
 		if err != nil {
 			return applyExcludeUUIDs(targets, cfg.ExcludeUUIDs), fmt.Errorf("discover managed databases: %w", err)
 		}
-		targets = append(targets, discovered...)
 	}
 	return applyExcludeUUIDs(targets, cfg.ExcludeUUIDs), nil
 }
 
-func resolveManagedLoadBalancerUUIDs(ctx context.Context, client Client, cfg ManagedLoadBalancerConfig) ([]string, error) {
+// resolveManagedLoadBalancerUUIDs is the load balancer counterpart of
+// resolveManagedDatabaseUUIDs.
+func resolveManagedLoadBalancerUUIDs(ctx context.Context, client Client, cfg ManagedLoadBalancerConfig, cache *discoveryCache) ([]string, error) {
 	targets := append([]string(nil), cfg.UUIDs...)
 	if cfg.AutoDiscover {
-		discovered, err := client.ListManagedLoadBalancerUUIDs(ctx, cfg.DiscoveryPath)
+		discovered, err := cache.lookup(resourceTypeManagedLoadBalancer, func() ([]ResourceInfo, error) {
+			return client.ListManagedLoadBalancers(ctx, cfg.DiscoveryPath)
+		})
+		selected, selectErr := selectResources(discovered, cfg.Selector)
+		if selectErr != nil {
+			return nil, fmt.Errorf("managed_load_balancers.selector: %w", selectErr)
+		}
+		targets = append(targets, resourceUUIDs(selected)...)
 		if err != nil {
 			return applyExcludeUUIDs(targets, cfg.ExcludeUUIDs), fmt.Errorf("discover managed load balancers: %w", err)
 		}
-		targets = append(targets, discovered...)
 	}
 	return applyExcludeUUIDs(targets, cfg.ExcludeUUIDs), nil
 }
@@ -5,10 +5,18 @@ package upcloudreceiver
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
 	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
 )
 
 type fakeClient struct {
@@ -26,19 +34,35 @@ func (f *fakeClient) GetManagedLoadBalancerMetrics(context.Context, string, stri
 	return f.lbResp, nil
 }
 
-func (f *fakeClient) ListManagedDatabaseServiceUUIDs(context.Context, string, int) ([]string, error) {
-	return f.dbList, nil
+func (f *fakeClient) ListManagedDatabases(context.Context, string, int) ([]ResourceInfo, error) {
+	return toResourceInfos(f.dbList), nil
+}
+
+func (f *fakeClient) ListManagedLoadBalancers(context.Context, string) ([]ResourceInfo, error) {
+	return toResourceInfos(f.lbList), nil
+}
+
+func (f *fakeClient) GetManagedDatabase(_ context.Context, _ string, uuid string) (ResourceInfo, error) {
+	return ResourceInfo{UUID: uuid}, nil
 }
 
-func (f *fakeClient) ListManagedLoadBalancerUUIDs(context.Context, string) ([]string, error) {
-	return f.lbList, nil
+func (f *fakeClient) GetManagedLoadBalancer(_ context.Context, _ string, uuid string) (ResourceInfo, error) {
+	return ResourceInfo{UUID: uuid}, nil
+}
+
+func toResourceInfos(uuids []string) []ResourceInfo {
+	resources := make([]ResourceInfo, 0, len(uuids))
+	for _, uuid := range uuids {
+		resources = append(resources, ResourceInfo{UUID: uuid})
+	}
+	return resources
 }
 
 func TestScrapeMetricsManagedDatabase(t *testing.T) {
 	cfg := &Config{
-		CollectionInterval: 60,
-		InitialDelay:       0,
-		API:                APIConfig{Endpoint: "https://api.upcloud.com", Token: "token", Timeout: 10},
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ControllerConfig:     scraperhelper.ControllerConfig{CollectionInterval: 60, InitialDelay: 0},
+		API:                  APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
 		ManagedDatabases: ManagedDatabaseConfig{
 			Enabled: true,
 			UUIDs:   []string{"db-uuid"},
@@ -64,7 +88,7 @@ func TestScrapeMetricsManagedDatabase(t *testing.T) {
 		},
 	}
 
-	metrics, err := scrapeMetrics(context.Background(), client, cfg, zap.NewNop())
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
 	if err != nil {
 		t.Fatalf("unexpected scrape error: %v", err)
 	}
@@ -99,9 +123,9 @@ func TestScrapeMetricsManagedDatabase(t *testing.T) {
 
 func TestScrapeMetricsAutoDiscoverManagedDatabase(t *testing.T) {
 	cfg := &Config{
-		CollectionInterval: 60,
-		InitialDelay:       0,
-		API:                APIConfig{Endpoint: "https://api.upcloud.com", Token: "token", Timeout: 10},
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ControllerConfig:     scraperhelper.ControllerConfig{CollectionInterval: 60, InitialDelay: 0},
+		API:                  APIConfig{ClientConfig: confighttp.ClientConfig{Endpoint: "https://api.upcloud.com", Timeout: 10}, Token: "token"},
 		ManagedDatabases: ManagedDatabaseConfig{
 			Enabled:        true,
 			AutoDiscover:   true,
@@ -130,7 +154,7 @@ func TestScrapeMetricsAutoDiscoverManagedDatabase(t *testing.T) {
 		},
 	}
 
-	metrics, err := scrapeMetrics(context.Background(), client, cfg, zap.NewNop())
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
 	if err != nil {
 		t.Fatalf("unexpected scrape error: %v", err)
 	}
@@ -142,3 +166,171 @@ func TestScrapeMetricsAutoDiscoverManagedDatabase(t *testing.T) {
 		t.Fatalf("unexpected discovered uuid: %v", got)
 	}
 }
+
+// latencyClient returns a per-UUID payload after an injected delay and tracks
+// the peak number of in-flight metrics calls.
+type latencyClient struct {
+	fakeClient
+	latency  func(uuid string) time.Duration
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (c *latencyClient) GetManagedDatabaseMetrics(ctx context.Context, uuid string, _ string) (MetricsResponse, error) {
+	current := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+	for {
+		peak := c.peak.Load()
+		if current <= peak || c.peak.CompareAndSwap(peak, current) {
+			break
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(c.latency(uuid)):
+	}
+	return MetricsResponse{
+		"cpu_usage": {
+			Data: MetricsData{
+				Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: uuid, Type: "number"}},
+				Rows: [][]any{{"2026-02-21T08:00:00Z", 10.0}},
+			},
+		},
+	}, nil
+}
+
+func TestScrapeMetrics_ConcurrentOrderingIsDeterministic(t *testing.T) {
+	uuids := make([]string, 0, 12)
+	for i := 0; i < 12; i++ {
+		uuids = append(uuids, fmt.Sprintf("db-%02d", i))
+	}
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		MaxConcurrency:       4,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: uuids},
+	}
+	// Later UUIDs finish first so completion order is the reverse of target order.
+	client := &latencyClient{latency: func(uuid string) time.Duration {
+		var idx int
+		_, _ = fmt.Sscanf(uuid, "db-%d", &idx)
+		return time.Duration(len(uuids)-idx) * time.Millisecond
+	}}
+
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type)), zap.NewNop())
+	if err != nil {
+		t.Fatalf("unexpected scrape error: %v", err)
+	}
+	if metrics.ResourceMetrics().Len() != len(uuids) {
+		t.Fatalf("expected %d resource metrics, got %d", len(uuids), metrics.ResourceMetrics().Len())
+	}
+	for i, want := range uuids {
+		got := metrics.ResourceMetrics().At(i).Resource().Attributes().AsRaw()["upcloud.resource.uuid"]
+		if got != want {
+			t.Fatalf("resource %d: expected %s, got %v", i, want, got)
+		}
+	}
+	if peak := client.peak.Load(); peak > 4 || peak < 2 {
+		t.Fatalf("expected bounded concurrency between 2 and 4, got peak %d", peak)
+	}
+}
+
+func benchmarkScrapeMetricsConcurrency(b *testing.B, maxConcurrency int) {
+	uuids := make([]string, 0, 50)
+	for i := 0; i < 50; i++ {
+		uuids = append(uuids, fmt.Sprintf("db-%02d", i))
+	}
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		MaxConcurrency:       maxConcurrency,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: uuids},
+	}
+	client := &latencyClient{latency: func(string) time.Duration { return time.Millisecond }}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+	logger := zap.NewNop()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := scrapeMetrics(context.Background(), client, cfg, state, logger); err != nil {
+			b.Fatalf("unexpected scrape error: %v", err)
+		}
+	}
+}
+
+func BenchmarkScrapeMetrics_Sequential(b *testing.B) {
+	benchmarkScrapeMetricsConcurrency(b, 1)
+}
+
+func BenchmarkScrapeMetrics_Concurrency8(b *testing.B) {
+	benchmarkScrapeMetricsConcurrency(b, 8)
+}
+
+func BenchmarkScrapeMetrics_Concurrency32(b *testing.B) {
+	benchmarkScrapeMetricsConcurrency(b, 32)
+}
+
+func TestScrapeMetrics_BackfillEmitsNewRowsOnce(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Backfill:             true,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-uuid"}},
+	}
+	payload := func(rows ...[]any) MetricsResponse {
+		return MetricsResponse{
+			"cpu_usage": {
+				Data: MetricsData{
+					Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}},
+					Rows: rows,
+				},
+			},
+		}
+	}
+	client := &fakeClient{dbResp: payload(
+		[]any{"2026-02-21T08:00:00Z", 10.0},
+		[]any{"2026-02-21T08:01:00Z", 20.0},
+		[]any{"2026-02-21T08:02:00Z", 30.0},
+	)}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop())
+	if err != nil {
+		t.Fatalf("first scrape: %v", err)
+	}
+	dps := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	if dps.Len() != 3 {
+		t.Fatalf("expected every row of the period on the first scrape, got %d points", dps.Len())
+	}
+	for i, want := range []string{"2026-02-21T08:00:00Z", "2026-02-21T08:01:00Z", "2026-02-21T08:02:00Z"} {
+		if got := dps.At(i).Timestamp().AsTime().Format(time.RFC3339); got != want {
+			t.Fatalf("point %d: expected timestamp %s, got %s", i, want, got)
+		}
+	}
+
+	state.commit()
+
+	// The next period overlaps the previous one; only the new row is emitted.
+	client.dbResp = payload(
+		[]any{"2026-02-21T08:01:00Z", 20.0},
+		[]any{"2026-02-21T08:02:00Z", 30.0},
+		[]any{"2026-02-21T08:03:00Z", 40.0},
+	)
+	metrics, err = scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop())
+	if err != nil {
+		t.Fatalf("second scrape: %v", err)
+	}
+	dps = metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	if dps.Len() != 1 || dps.At(0).DoubleValue() != 40.0 {
+		t.Fatalf("expected only the 08:03 row, got %d points", dps.Len())
+	}
+
+	state.commit()
+
+	metrics, err = scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop())
+	if err != nil {
+		t.Fatalf("third scrape: %v", err)
+	}
+	if metrics.ResourceMetrics().Len() != 0 {
+		t.Fatalf("expected no resources when nothing new was returned, got %d", metrics.ResourceMetrics().Len())
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+)
+
+// selectResources returns the discovered resources that match cfg.
+func selectResources(discovered []ResourceInfo, cfg SelectorConfig) ([]ResourceInfo, error) {
+	if !cfg.isSet() {
+		return discovered, nil
+	}
+	selector, err := newResourceSelector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return selector.filter(discovered), nil
+}
+
+// resourceSelector is the compiled form of a SelectorConfig.
+type resourceSelector struct {
+	labels     map[string]string
+	labelRegex map[string]*regexp.Regexp
+	zones      []string
+	names      []string
+	states     []string
+}
+
+// newResourceSelector compiles cfg. Regular expressions must match the whole
+// label value.
+func newResourceSelector(cfg SelectorConfig) (*resourceSelector, error) {
+	selector := &resourceSelector{
+		labels: cfg.Labels,
+		zones:  cfg.Zones,
+		names:  cfg.Names,
+		states: cfg.States,
+	}
+	keys := make([]string, 0, len(cfg.LabelRegex))
+	for key := range cfg.LabelRegex {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		re, err := regexp.Compile("^(?:" + cfg.LabelRegex[key] + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("label_regex %q is invalid: %w", key, err)
+		}
+		if selector.labelRegex == nil {
+			selector.labelRegex = make(map[string]*regexp.Regexp, len(cfg.LabelRegex))
+		}
+		selector.labelRegex[key] = re
+	}
+	for _, pattern := range cfg.Names {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("names pattern %q is invalid: %w", pattern, err)
+		}
+	}
+	return selector, nil
+}
+
+// matches reports whether info satisfies every rule of the selector. A rule
+// that is not set matches every resource; a list rule matches when any of
+// its entries does.
+func (s *resourceSelector) matches(info ResourceInfo) bool {
+	for key, want := range s.labels {
+		if value, ok := info.Labels[key]; !ok || value != want {
+			return false
+		}
+	}
+	for key, re := range s.labelRegex {
+		if value, ok := info.Labels[key]; !ok || !re.MatchString(value) {
+			return false
+		}
+	}
+	if len(s.zones) > 0 && !slices.Contains(s.zones, info.Zone) {
+		return false
+	}
+	if len(s.names) > 0 && !matchesAny(s.names, info.Name) {
+		return false
+	}
+	if len(s.states) > 0 && !slices.Contains(s.states, info.State) {
+		return false
+	}
+	return true
+}
+
+// filter returns the resources that match the selector.
+func (s *resourceSelector) filter(resources []ResourceInfo) []ResourceInfo {
+	selected := make([]ResourceInfo, 0, len(resources))
+	for _, info := range resources {
+		if s.matches(info) {
+			selected = append(selected, info)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+func TestResourceSelector_Matches(t *testing.T) {
+	info := ResourceInfo{
+		UUID:   "db-1",
+		Name:   "payments-staging",
+		Zone:   "fi-hel2",
+		State:  "running",
+		Labels: map[string]string{"env": "staging", "team": "payments"},
+	}
+	tests := []struct {
+		name string
+		cfg  SelectorConfig
+		want bool
+	}{
+		{name: "no rules", cfg: SelectorConfig{}, want: true},
+		{name: "label equality", cfg: SelectorConfig{Labels: map[string]string{"env": "staging"}}, want: true},
+		{name: "label mismatch", cfg: SelectorConfig{Labels: map[string]string{"env": "prod"}}, want: false},
+		{name: "missing label", cfg: SelectorConfig{Labels: map[string]string{"owner": "x"}}, want: false},
+		{name: "label regex", cfg: SelectorConfig{LabelRegex: map[string]string{"team": "pay.*|orders"}}, want: true},
+		{name: "label regex matches in full", cfg: SelectorConfig{LabelRegex: map[string]string{"team": "pay"}}, want: false},
+		{name: "zone list", cfg: SelectorConfig{Zones: []string{"de-fra1", "fi-hel2"}}, want: true},
+		{name: "other zone", cfg: SelectorConfig{Zones: []string{"de-fra1"}}, want: false},
+		{name: "name glob", cfg: SelectorConfig{Names: []string{"*-staging"}}, want: true},
+		{name: "name glob mismatch", cfg: SelectorConfig{Names: []string{"*-prod"}}, want: false},
+		{name: "state", cfg: SelectorConfig{States: []string{"running"}}, want: true},
+		{name: "other state", cfg: SelectorConfig{States: []string{"stopped"}}, want: false},
+		{
+			name: "every rule must match",
+			cfg:  SelectorConfig{Labels: map[string]string{"env": "staging"}, Zones: []string{"de-fra1"}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := newResourceSelector(tt.cfg)
+			if err != nil {
+				t.Fatalf("new selector: %v", err)
+			}
+			if got := selector.matches(info); got != tt.want {
+				t.Fatalf("matches() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewResourceSelector_Invalid(t *testing.T) {
+	if _, err := newResourceSelector(SelectorConfig{LabelRegex: map[string]string{"env": "("}}); err == nil {
+		t.Fatalf("expected an invalid regular expression to fail")
+	}
+	if _, err := newResourceSelector(SelectorConfig{Names: []string{"[db"}}); err == nil {
+		t.Fatalf("expected an invalid name pattern to fail")
+	}
+}
+
+func TestResolveManagedDatabaseUUIDs_Selector(t *testing.T) {
+	client := &discoveryClient{databases: []ResourceInfo{
+		{UUID: "db-1", Zone: "fi-hel2", Labels: map[string]string{"env": "staging"}},
+		{UUID: "db-2", Zone: "de-fra1", Labels: map[string]string{"env": "staging"}},
+		{UUID: "db-3", Zone: "fi-hel2", Labels: map[string]string{"env": "prod"}},
+	}}
+	cfg := ManagedDatabaseConfig{
+		Enabled:      true,
+		AutoDiscover: true,
+		UUIDs:        []string{"db-9"},
+		Selector: SelectorConfig{
+			Labels: map[string]string{"env": "staging"},
+			Zones:  []string{"fi-hel2"},
+		},
+	}
+
+	uuids, err := resolveManagedDatabaseUUIDs(context.Background(), client, cfg, nil)
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	// Explicit UUIDs are not subject to the selector.
+	if want := []string{"db-1", "db-9"}; !reflect.DeepEqual(uuids, want) {
+		t.Fatalf("resolved %v, want %v", uuids, want)
+	}
+}
+
+func TestScrapeMetrics_SelectorDropsResourcesThatStopMatching(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases: ManagedDatabaseConfig{
+			Enabled:      true,
+			AutoDiscover: true,
+			Selector:     SelectorConfig{States: []string{"running"}},
+		},
+	}
+	client := &discoveryClient{
+		fakeClient: fakeClient{dbResp: cpuUsageResponse()},
+		databases: []ResourceInfo{
+			{UUID: "db-1", State: "running"},
+			{UUID: "db-2", State: "running"},
+		},
+	}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+
+	if _, err := scrapeAndCommit(client, cfg, state); err != nil {
+		t.Fatalf("first scrape: %v", err)
+	}
+	client.databases[1].State = "maintenance"
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop())
+	if err != nil {
+		t.Fatalf("second scrape: %v", err)
+	}
+	if got := countStaleDataPoints(metrics); got != 1 {
+		t.Fatalf("expected staleness markers for the database that stopped matching, got %d", got)
+	}
+}
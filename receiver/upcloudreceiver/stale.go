@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+// staleTracker remembers the targets of the previous scrape and the series
+// exported for each of them, so that the series of a resource that
+// disappeared from discovery are ended with a NoRecordedValue data point
+// instead of going silent. A new target set only replaces the previous one
+// when the batch carrying the markers is committed; after a discarded batch
+// the markers are emitted again.
+type staleTracker struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	targets   map[string]map[string]struct{}
+	pending   map[string]map[string]struct{}
+	resources map[string]*knownResource
+}
+
+// knownResource holds the resource attributes of a target and one data point
+// per exported series, keyed by metric name and data point attributes.
+type knownResource struct {
+	resource pcommon.Resource
+	series   map[string]pmetric.Metric
+}
+
+func newStaleTracker() *staleTracker {
+	return &staleTracker{
+		now:       time.Now,
+		targets:   make(map[string]map[string]struct{}),
+		pending:   make(map[string]map[string]struct{}),
+		resources: make(map[string]*knownResource),
+	}
+}
+
+// remember records the series of an exported resource.
+func (t *staleTracker) remember(resourceType string, uuid string, rm pmetric.ResourceMetrics) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := resourceKey(resourceType, uuid)
+	known, ok := t.resources[key]
+	if !ok {
+		known = &knownResource{resource: pcommon.NewResource(), series: make(map[string]pmetric.Metric)}
+		t.resources[key] = known
+	}
+	rm.Resource().CopyTo(known.resource)
+
+	for i := 0; i < rm.ScopeMetrics().Len(); i++ {
+		metrics := rm.ScopeMetrics().At(i).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			m := metrics.At(j)
+			dps, ok := numberDataPoints(m)
+			if !ok {
+				continue
+			}
+			for k := 0; k < dps.Len(); k++ {
+				series := pmetric.NewMetric()
+				series.SetName(m.Name())
+				series.SetUnit(m.Unit())
+				series.SetDescription(m.Description())
+				copyMetricType(m, series)
+				target, _ := numberDataPoints(series)
+				dps.At(k).CopyTo(target.AppendEmpty())
+				known.series[m.Name()+"\x00"+attributesKey(dps.At(k).Attributes())] = series
+			}
+		}
+	}
+}
+
+// markVanished appends staleness markers for every target of the previous
+// scrape of resourceType that is missing from targets, and returns the UUIDs
+// of those resources. targets becomes the new target set on commit.
+func (t *staleTracker) markVanished(out pmetric.Metrics, resourceType string, targets []string) []string {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current := make(map[string]struct{}, len(targets))
+	for _, uuid := range targets {
+		current[uuid] = struct{}{}
+	}
+	t.pending[resourceType] = current
+
+	vanished := vanishedTargets(t.targets[resourceType], current)
+	timestamp := pcommon.NewTimestampFromTime(t.now())
+	for _, uuid := range vanished {
+		known := t.resources[resourceKey(resourceType, uuid)]
+		if known == nil || len(known.series) == 0 {
+			continue
+		}
+		appendStaleMarkers(out, known, timestamp)
+	}
+	return vanished
+}
+
+// commit replaces the target sets with the ones of the consumed batch and
+// forgets the series of the resources that vanished.
+func (t *staleTracker) commit() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for resourceType, current := range t.pending {
+		for _, uuid := range vanishedTargets(t.targets[resourceType], current) {
+			delete(t.resources, resourceKey(resourceType, uuid))
+		}
+		t.targets[resourceType] = current
+	}
+	clear(t.pending)
+}
+
+// discard keeps the previous target sets, so the markers of a rejected batch
+// are emitted again by the next scrape.
+func (t *staleTracker) discard() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	clear(t.pending)
+}
+
+func vanishedTargets(previous map[string]struct{}, current map[string]struct{}) []string {
+	var vanished []string
+	for uuid := range previous {
+		if _, ok := current[uuid]; !ok {
+			vanished = append(vanished, uuid)
+		}
+	}
+	sort.Strings(vanished)
+	return vanished
+}
+
+// appendStaleMarkers appends one NoRecordedValue data point per known series.
+func appendStaleMarkers(out pmetric.Metrics, known *knownResource, timestamp pcommon.Timestamp) {
+	rm := out.ResourceMetrics().AppendEmpty()
+	known.resource.CopyTo(rm.Resource())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(metadata.ScopeName)
+
+	keys := make([]string, 0, len(known.series))
+	for key := range known.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	byName := make(map[string]pmetric.Metric)
+	for _, key := range keys {
+		series := known.series[key]
+		dest, ok := byName[series.Name()]
+		if !ok {
+			dest = sm.Metrics().AppendEmpty()
+			dest.SetName(series.Name())
+			dest.SetUnit(series.Unit())
+			dest.SetDescription(series.Description())
+			copyMetricType(series, dest)
+			byName[series.Name()] = dest
+		}
+		source, _ := numberDataPoints(series)
+		target, _ := numberDataPoints(dest)
+		dp := target.AppendEmpty()
+		source.At(0).CopyTo(dp)
+		dp.SetTimestamp(timestamp)
+		dp.SetDoubleValue(0)
+		dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+	}
+}
+
+// numberDataPoints returns the data points of a gauge or sum metric.
+func numberDataPoints(m pmetric.Metric) (pmetric.NumberDataPointSlice, bool) {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		return m.Gauge().DataPoints(), true
+	case pmetric.MetricTypeSum:
+		return m.Sum().DataPoints(), true
+	default:
+		return pmetric.NumberDataPointSlice{}, false
+	}
+}
+
+// copyMetricType gives dest the data type of src without copying data points.
+func copyMetricType(src pmetric.Metric, dest pmetric.Metric) {
+	switch src.Type() {
+	case pmetric.MetricTypeGauge:
+		dest.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		sum := dest.SetEmptySum()
+		sum.SetIsMonotonic(src.Sum().IsMonotonic())
+		sum.SetAggregationTemporality(src.Sum().AggregationTemporality())
+	}
+}
+
+// attributesKey returns a stable string form of a data point attribute set.
+func attributesKey(attrs pcommon.Map) string {
+	parts := make([]string, 0, attrs.Len())
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		parts = append(parts, k+"="+v.AsString())
+		return true
+	})
+	sort.Strings(parts)
+	return strings.Join(parts, "\x00")
+}
@@ -0,0 +1,289 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/upcloud-community/opentelemetry-upcloud-receiver/receiver/upcloudreceiver/internal/metadata"
+)
+
+func TestScrapeMetrics_StaleMarkersForVanishedResources(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, AutoDiscover: true},
+	}
+	client := &fakeClient{
+		dbList: []string{"db-1", "db-2"},
+		dbResp: MetricsResponse{
+			"cpu_usage": {
+				Data: MetricsData{
+					Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}, {Label: "replica", Type: "number"}},
+					Rows: [][]any{{"2026-02-21T08:00:00Z", 10.0, 12.0}},
+				},
+			},
+		},
+	}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+	now := time.Date(2026, 2, 21, 8, 1, 0, 0, time.UTC)
+	state.stale.now = func() time.Time { return now }
+
+	metrics, err := scrapeAndCommit(client, cfg, state)
+	if err != nil {
+		t.Fatalf("first scrape: %v", err)
+	}
+	if metrics.ResourceMetrics().Len() != 2 {
+		t.Fatalf("expected 2 resources, got %d", metrics.ResourceMetrics().Len())
+	}
+
+	client.dbList = []string{"db-1"}
+	metrics, err = scrapeAndCommit(client, cfg, state)
+	if err != nil {
+		t.Fatalf("second scrape: %v", err)
+	}
+	if metrics.ResourceMetrics().Len() != 2 {
+		t.Fatalf("expected the live resource plus one stale resource, got %d", metrics.ResourceMetrics().Len())
+	}
+
+	stale := metrics.ResourceMetrics().At(0)
+	if uuid, _ := stale.Resource().Attributes().Get("upcloud.resource.uuid"); uuid.Str() != "db-2" {
+		t.Fatalf("expected stale markers for db-2, got %q", uuid.Str())
+	}
+	dps := stale.ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	if dps.Len() != 2 {
+		t.Fatalf("expected one marker per series, got %d", dps.Len())
+	}
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if !dp.Flags().NoRecordedValue() {
+			t.Fatalf("expected NoRecordedValue flag on marker %d", i)
+		}
+		if !dp.Timestamp().AsTime().Equal(now) {
+			t.Fatalf("expected marker timestamp %s, got %s", now, dp.Timestamp().AsTime())
+		}
+		if _, ok := dp.Attributes().Get("upcloud.node.role"); !ok {
+			t.Fatalf("expected marker %d to keep the node attributes", i)
+		}
+	}
+
+	metrics, err = scrapeAndCommit(client, cfg, state)
+	if err != nil {
+		t.Fatalf("third scrape: %v", err)
+	}
+	if metrics.ResourceMetrics().Len() != 1 {
+		t.Fatalf("expected stale markers to be emitted only once, got %d resources", metrics.ResourceMetrics().Len())
+	}
+}
+
+func TestScrapeMetrics_NoStaleMarkersWhenDiscoveryFails(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, AutoDiscover: true},
+	}
+	client := &discoveryFailingClient{fakeClient: fakeClient{
+		dbList: []string{"db-1"},
+		dbResp: MetricsResponse{
+			"cpu_usage": {
+				Data: MetricsData{
+					Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}},
+					Rows: [][]any{{"2026-02-21T08:00:00Z", 10.0}},
+				},
+			},
+		},
+	}}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+
+	if _, err := scrapeAndCommit(client, cfg, state); err != nil {
+		t.Fatalf("first scrape: %v", err)
+	}
+
+	client.fail = true
+	metrics, err := scrapeAndCommit(client, cfg, state)
+	if err == nil {
+		t.Fatalf("expected discovery error")
+	}
+	if got := countStaleDataPoints(metrics); got != 0 {
+		t.Fatalf("expected no stale markers after a failed discovery, got %d", got)
+	}
+}
+
+func TestScrapeMetrics_DiscoveryFailureIsNotAFailedResource(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, AutoDiscover: true, UUIDs: []string{"db-2"}},
+	}
+	client := &discoveryFailingClient{fakeClient: fakeClient{dbResp: cpuUsageResponse()}, fail: true}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+
+	metrics, err := scrapeAndCommit(client, cfg, state)
+	var partialErr scrapererror.PartialScrapeError
+	if !errors.As(err, &partialErr) {
+		t.Fatalf("expected a partial scrape error, got %v", err)
+	}
+	if partialErr.Failed != 0 {
+		t.Fatalf("expected no failed resources for a discovery error, got %d", partialErr.Failed)
+	}
+	if metrics.ResourceMetrics().Len() != 1 {
+		t.Fatalf("expected the configured database to be scraped, got %d resources", metrics.ResourceMetrics().Len())
+	}
+}
+
+// scrapeAndCommit scrapes like the receiver does for a batch that the next
+// consumer accepted.
+func scrapeAndCommit(client Client, cfg *Config, state *scrapeState) (pmetric.Metrics, error) {
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop())
+	state.commit()
+	return metrics, err
+}
+
+func TestScrapeMetrics_VanishedStateKeptUntilCommit(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		DiscoveryInterval:    time.Hour,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, UUIDs: []string{"db-1", "db-9"}},
+	}
+	client := &discoveryClient{fakeClient: fakeClient{dbResp: cpuUsageResponse()}}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+	if _, err := scrapeAndCommit(client, cfg, state); err != nil {
+		t.Fatalf("first scrape: %v", err)
+	}
+
+	vanished := resourceKey(resourceTypeManagedDatabase, "db-9")
+	known := func() (bool, bool) {
+		state.periods.mu.Lock()
+		_, period := state.periods.lastSuccess[vanished]
+		state.periods.mu.Unlock()
+		state.discovery.mu.Lock()
+		_, details := state.discovery.details[vanished]
+		state.discovery.mu.Unlock()
+		return period, details
+	}
+
+	cfg.ManagedDatabases.UUIDs = []string{"db-1"}
+	if _, err := scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop()); err != nil {
+		t.Fatalf("second scrape: %v", err)
+	}
+	state.discard()
+	if period, details := known(); !period || !details {
+		t.Fatalf("expected a discarded batch to keep the state of db-9, got period=%t details=%t", period, details)
+	}
+
+	if _, err := scrapeAndCommit(client, cfg, state); err != nil {
+		t.Fatalf("third scrape: %v", err)
+	}
+	if period, details := known(); period || details {
+		t.Fatalf("expected a committed batch to drop the state of db-9, got period=%t details=%t", period, details)
+	}
+}
+
+func TestScrapeMetrics_StaleMarkersRepeatedAfterDiscard(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Backfill:             true,
+		ManagedDatabases:     ManagedDatabaseConfig{Enabled: true, AutoDiscover: true},
+	}
+	client := &fakeClient{
+		dbList: []string{"db-1", "db-2"},
+		dbResp: MetricsResponse{
+			"cpu_usage": {
+				Data: MetricsData{
+					Cols: []MetricsColumn{{Label: "time", Type: "date"}, {Label: "primary", Type: "number"}},
+					Rows: [][]any{{"2026-02-21T08:00:00Z", 10.0}},
+				},
+			},
+		},
+	}
+	state := newScrapeState(cfg, receivertest.NewNopSettings(metadata.Type))
+	if _, err := scrapeAndCommit(client, cfg, state); err != nil {
+		t.Fatalf("first scrape: %v", err)
+	}
+
+	client.dbList = []string{"db-1"}
+	metrics, err := scrapeMetrics(context.Background(), client, cfg, state, zap.NewNop())
+	if err != nil {
+		t.Fatalf("second scrape: %v", err)
+	}
+	if got := countStaleDataPoints(metrics); got != 1 {
+		t.Fatalf("expected 1 staleness marker, got %d", got)
+	}
+	// The next consumer rejected the batch: the markers must be emitted again.
+	state.discard()
+
+	metrics, err = scrapeAndCommit(client, cfg, state)
+	if err != nil {
+		t.Fatalf("third scrape: %v", err)
+	}
+	if got := countStaleDataPoints(metrics); got != 1 {
+		t.Fatalf("expected the staleness marker to be repeated after a discarded batch, got %d", got)
+	}
+
+	// Once committed, the vanished database leaves no checkpoints behind.
+	removed := seriesKey{ResourceType: resourceTypeManagedDatabase, ResourceUUID: "db-2", Metric: "cpu_usage", Series: "primary"}
+	if got := state.checkpoints.since(removed); !got.IsZero() {
+		t.Fatalf("expected checkpoints of db-2 to be dropped, got %s", got)
+	}
+	kept := seriesKey{ResourceType: resourceTypeManagedDatabase, ResourceUUID: "db-1", Metric: "cpu_usage", Series: "primary"}
+	if got := state.checkpoints.since(kept); got.IsZero() {
+		t.Fatalf("expected checkpoints of db-1 to be kept")
+	}
+	data, err := state.checkpoints.marshal()
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if strings.Contains(string(data), "db-2") {
+		t.Fatalf("expected persisted state without db-2, got %s", data)
+	}
+
+	metrics, err = scrapeAndCommit(client, cfg, state)
+	if err != nil {
+		t.Fatalf("fourth scrape: %v", err)
+	}
+	if got := countStaleDataPoints(metrics); got != 0 {
+		t.Fatalf("expected no more staleness markers after commit, got %d", got)
+	}
+}
+
+// discoveryFailingClient fails database discovery once fail is set.
+type discoveryFailingClient struct {
+	fakeClient
+	fail bool
+}
+
+func (c *discoveryFailingClient) ListManagedDatabases(ctx context.Context, path string, limit int) ([]ResourceInfo, error) {
+	if c.fail {
+		return nil, &APIError{StatusCode: 503, Endpoint: path}
+	}
+	return c.fakeClient.ListManagedDatabases(ctx, path, limit)
+}
+
+func countStaleDataPoints(md pmetric.Metrics) int {
+	count := 0
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				dps, ok := numberDataPoints(ms.At(k))
+				if !ok {
+					continue
+				}
+				for l := 0; l < dps.Len(); l++ {
+					if dps.At(l).Flags().NoRecordedValue() {
+						count++
+					}
+				}
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package upcloudreceiver
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/zap"
+)
+
+const checkpointStorageKey = "checkpoints"
+
+// newStorageClient returns a client of the configured storage extension, or a
+// no-op client when no storage is configured.
+func newStorageClient(ctx context.Context, host component.Host, storageID *component.ID, ownerID component.ID) (storage.Client, error) {
+	if storageID == nil {
+		return storage.NewNopClient(), nil
+	}
+	if host == nil {
+		return nil, fmt.Errorf("storage extension %s not found", storageID)
+	}
+	ext, ok := host.GetExtensions()[*storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension %s not found", storageID)
+	}
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("extension %s is not a storage extension", storageID)
+	}
+	return storageExt.GetClient(ctx, component.KindReceiver, ownerID, "")
+}
+
+// checkpointKey is the storage key of the checkpoints of one account.
+func checkpointKey(account string) string {
+	if account == "" {
+		return checkpointStorageKey
+	}
+	return checkpointStorageKey + "/" + account
+}
+
+// loadCheckpoints restores the persisted checkpoints of every account. Missing
+// state starts from scratch; unreadable or corrupt state is logged and
+// ignored, so the receiver always starts.
+func (s *upcloudScraper) loadCheckpoints(ctx context.Context) {
+	for _, account := range s.accounts {
+		cp := account.checkpoints()
+		if cp == nil {
+			continue
+		}
+		key := checkpointKey(account.name)
+		data, err := s.storage.Get(ctx, key)
+		if err != nil {
+			s.settings.Logger.Warn("Failed to load UpCloud checkpoints, starting without them", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		if data == nil {
+			continue
+		}
+		if err := cp.unmarshal(data); err != nil {
+			s.settings.Logger.Warn("Ignoring corrupt UpCloud checkpoint state", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+// commitState commits the scrape state of a consumed batch and persists the
+// checkpoints of the accounts whose checkpoints changed.
+func (s *upcloudScraper) commitState(ctx context.Context) {
+	for _, account := range s.accounts {
+		if account.state == nil || !account.state.commit() {
+			continue
+		}
+		key := checkpointKey(account.name)
+		data, err := account.checkpoints().marshal()
+		if err == nil {
+			err = s.storage.Set(ctx, key, data)
+		}
+		if err != nil {
+			s.settings.Logger.Warn("Failed to persist UpCloud checkpoints", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+// discardState drops the progress of a batch that was not consumed.
+func (s *upcloudScraper) discardState() {
+	for _, account := range s.accounts {
+		if account.state != nil {
+			account.state.discard()
+		}
+	}
+}